@@ -0,0 +1,146 @@
+package tableprinter
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Aggregate reduces the original values of a column to a single value (eg for a footer).
+// Values which couldn't be reflected (such as nil pointers and unexported fields) are passed as nil:
+type Aggregate func(values []interface{}) interface{}
+
+// Avg is the mean of the numeric values in a column:
+func Avg(values []interface{}) interface{} {
+	var count int
+	var total float64
+
+	for _, value := range values {
+		if number, ok := newCell(value, "").number(); ok {
+			total += number
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+
+	return total / float64(count)
+}
+
+// Count is the number of (non-nil) values in a column:
+func Count(values []interface{}) interface{} {
+	var count int
+	for _, value := range values {
+		if value != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// DistinctCount is the number of different (non-nil) values in a column:
+func DistinctCount(values []interface{}) interface{} {
+	var distinctValues = make(map[string]bool)
+	for _, value := range values {
+		if value != nil {
+			distinctValues[fmt.Sprintf("%T:%v", value, value)] = true
+		}
+	}
+	return len(distinctValues)
+}
+
+// Max is the largest (non-nil) value in a column (numbers are compared numerically, times chronologically):
+func Max(values []interface{}) interface{} {
+	return extremeValue(values, func(left, right cell) bool {
+		return lessCell(right, left)
+	})
+}
+
+// Min is the smallest (non-nil) value in a column (numbers are compared numerically, times chronologically):
+func Min(values []interface{}) interface{} {
+	return extremeValue(values, lessCell)
+}
+
+// Sum is the total of the numeric values in a column (whole numbers stay whole):
+func Sum(values []interface{}) interface{} {
+	var floatTotal float64
+	var integerTotal int64
+	var floats, integers int
+
+	for _, value := range values {
+		switch reflect.ValueOf(value).Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			integerTotal += reflect.ValueOf(value).Int()
+			integers++
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			integerTotal += int64(reflect.ValueOf(value).Uint())
+			integers++
+		case reflect.Float32, reflect.Float64:
+			floatTotal += reflect.ValueOf(value).Float()
+			floats++
+		}
+	}
+
+	switch {
+	case floats > 0:
+		return floatTotal + float64(integerTotal)
+	case integers > 0:
+		return integerTotal
+	default:
+		return nil
+	}
+}
+
+// extremeValue finds the value which comes first in an ordering:
+func extremeValue(values []interface{}, less func(left, right cell) bool) interface{} {
+	var extreme *cell
+
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		valueCell := newCell(value, fmt.Sprint(value))
+		if extreme == nil || less(valueCell, *extreme) {
+			extreme = &valueCell
+		}
+	}
+	if extreme == nil {
+		return nil
+	}
+
+	return extreme.value
+}
+
+// aggregateCell applies an aggregate to some values (no result makes an empty cell):
+func (p *Printer) aggregateCell(aggregate Aggregate, values []interface{}) cell {
+	if aggregateValue := aggregate(values); aggregateValue != nil {
+		return p.cellFromValue(aggregateValue)
+	}
+	return textCell("")
+}
+
+// checkAggregates makes sure that every column with an aggregate exists:
+func (t *Table) checkAggregates(aggregates map[string]Aggregate) error {
+	for header := range aggregates {
+		if !t.hasHeader(header) {
+			return ErrNoSuchCell
+		}
+	}
+	return nil
+}
+
+// aggregateRow applies aggregates to the columns of some rows:
+func (p *Printer) aggregateRow(rows []tableRow, aggregates map[string]Aggregate) tableRow {
+	var aggregateRow = make(tableRow)
+
+	for header, aggregate := range aggregates {
+		var values []interface{}
+		for _, row := range rows {
+			values = append(values, row[header].value)
+		}
+
+		aggregateRow.setField(header, p.aggregateCell(aggregate, values))
+	}
+
+	return aggregateRow
+}
@@ -0,0 +1,67 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+type aggregateTestRow struct {
+	Name   string
+	Weight int
+	Height float64
+	Crufty *bool
+}
+
+var aggregateTestRows = []aggregateTestRow{
+	{"cruft-1", 5, 1.5, nil},
+	{"cruft-2", 12, 2, new(bool)},
+	{"cruft-1", 7, 0.5, nil},
+}
+
+func TestFooter(t *testing.T) {
+	tablePrinter := tableprinter.New().WithFooter(map[string]tableprinter.Aggregate{
+		"Crufty": tableprinter.Count,
+		"Height": tableprinter.Max,
+		"Name":   tableprinter.DistinctCount,
+		"Weight": tableprinter.Sum,
+	})
+
+	marshaledBytes, err := tablePrinter.Marshal(aggregateTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  CRUFTY | HEIGHT |  NAME   | WEIGHT  \n+--------+--------+---------+--------+\n  <nil>  |    1.5 | cruft-1 |      5  \n  false  |      2 | cruft-2 |     12  \n  <nil>  |    0.5 | cruft-1 |      7  \n+--------+--------+---------+--------+\n       1 |      2 |       2 |     24  \n+--------+--------+---------+--------+\n", string(marshaledBytes))
+
+	// Columns without aggregates are left empty (their borders are left out too):
+	marshaledBytes, err = tablePrinter.WithFooter(map[string]tableprinter.Aggregate{"Weight": tableprinter.Max}).Marshal(aggregateTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  CRUFTY | HEIGHT |  NAME   | WEIGHT  \n+--------+--------+---------+--------+\n  <nil>  |    1.5 | cruft-1 |      5  \n  false  |      2 | cruft-2 |     12  \n  <nil>  |    0.5 | cruft-1 |      7  \n+--------+--------+---------+--------+\n                                  12  \n                            +--------+\n", string(marshaledBytes))
+
+	// Footer values are shown as they are (not like headers):
+	marshaledBytes, err = tablePrinter.WithFooter(map[string]tableprinter.Aggregate{"Name": tableprinter.Min}).Marshal(aggregateTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  CRUFTY | HEIGHT |  NAME   | WEIGHT  \n+--------+--------+---------+--------+\n  <nil>  |    1.5 | cruft-1 |      5  \n  false  |      2 | cruft-2 |     12  \n  <nil>  |    0.5 | cruft-1 |      7  \n+--------+--------+---------+--------+\n                    cruft-1 |         \n                  +---------+--------+\n", string(marshaledBytes))
+
+	// Columns have to exist:
+	_, err = tablePrinter.WithFooter(map[string]tableprinter.Aggregate{"Nope": tableprinter.Sum}).Marshal(aggregateTestRows)
+	assert.Equal(t, tableprinter.ErrNoSuchCell, err)
+}
+
+func TestAggregates(t *testing.T) {
+	values := []interface{}{3, nil, int64(-2), uint8(4), 3}
+
+	assert.Equal(t, int64(8), tableprinter.Sum(values))
+	assert.Equal(t, 4, tableprinter.Count(values))
+	assert.Equal(t, 3, tableprinter.DistinctCount(values))
+	assert.Equal(t, int64(-2), tableprinter.Min(values))
+	assert.Equal(t, uint8(4), tableprinter.Max(values))
+	assert.Equal(t, 2.0, tableprinter.Avg(values))
+
+	// Floats make floats:
+	assert.Equal(t, 3.5, tableprinter.Sum([]interface{}{1, 2.5}))
+
+	// Text can still be compared, but not added up:
+	assert.Equal(t, "a", tableprinter.Min([]interface{}{"b", "a", "c"}))
+	assert.Nil(t, tableprinter.Sum([]interface{}{"b", "a"}))
+	assert.Nil(t, tableprinter.Avg(nil))
+}
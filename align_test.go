@@ -0,0 +1,43 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+var alignTestRows = []struct {
+	Name    string
+	Enabled bool
+	Load    float64
+}{
+	{"cruft-1", true, 100},
+	{"cruft-2", false, 2.25},
+	{"cruft-3", true, 45.5},
+}
+
+func TestAlignment(t *testing.T) {
+	tablePrinter := tableprinter.New().WithBorders(true).WithSortedHeaders(false)
+
+	// Inferred from each column's kind (text on the left, bools in the centre, numbers on the right):
+	marshaledBytes, err := tablePrinter.Marshal(alignTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "+---------+---------+------+\n|  NAME   | ENABLED | LOAD |\n+---------+---------+------+\n| cruft-1 |  true   |  100 |\n| cruft-2 |  false  | 2.25 |\n| cruft-3 |  true   | 45.5 |\n+---------+---------+------+\n", string(marshaledBytes))
+
+	// Configured per column (values and headers):
+	marshaledBytes, err = tablePrinter.
+		WithColumnConfig("Name", tableprinter.ColumnConfig{Align: tableprinter.AlignRight, HeaderAlign: tableprinter.AlignLeft}).
+		WithColumnConfig("Enabled", tableprinter.ColumnConfig{Align: tableprinter.AlignLeft}).
+		Marshal(alignTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "+---------+---------+------+\n| NAME    | ENABLED | LOAD |\n+---------+---------+------+\n| cruft-1 | true    |  100 |\n| cruft-2 | false   | 2.25 |\n| cruft-3 | true    | 45.5 |\n+---------+---------+------+\n", string(marshaledBytes))
+
+	// Decimal points lined up (footers included):
+	marshaledBytes, err = tablePrinter.
+		WithColumnConfig("Load", tableprinter.ColumnConfig{Align: tableprinter.AlignDecimal}).
+		WithFooter(map[string]tableprinter.Aggregate{"Load": tableprinter.Sum}).
+		Marshal(alignTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "+---------+---------+--------+\n|  NAME   | ENABLED |  LOAD  |\n+---------+---------+--------+\n| cruft-1 |  true   | 100    |\n| cruft-2 |  false  |   2.25 |\n| cruft-3 |  true   |  45.5  |\n+=========+=========+========+\n|                     147.75 |\n+---------+---------+--------+\n", string(marshaledBytes))
+}
@@ -0,0 +1,81 @@
+package tableprinter
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// cell is a single value in a table, keeping the original value (and its kind) alongside how it is displayed:
+type cell struct {
+	kind   reflect.Kind
+	merged bool
+	text   string
+	value  interface{}
+}
+
+// newCell makes a cell from a value and its formatted text:
+func newCell(value interface{}, text string) cell {
+	return cell{
+		kind:  reflect.ValueOf(value).Kind(),
+		text:  strings.ReplaceAll(text, spewPointerString, ""),
+		value: value,
+	}
+}
+
+// textCell makes a cell which only has text (eg placeholders, markers and labels):
+func textCell(text string) cell {
+	return newCell(nil, text)
+}
+
+// withText returns a copy of the cell which is displayed differently (eg with colours):
+func (c cell) withText(text string) cell {
+	c.text = text
+	return c
+}
+
+// withMerged returns a copy of the cell which is (or isn't) merged with the one above it (so its text isn't displayed):
+func (c cell) withMerged(merged bool) cell {
+	c.merged = merged
+	return c
+}
+
+// isNumeric determines whether a cell holds a number:
+func (c cell) isNumeric() bool {
+	_, ok := c.number()
+	return ok
+}
+
+// number returns the value of a numeric cell as a float64:
+func (c cell) number() (float64, bool) {
+	switch c.kind {
+	case reflect.Float32, reflect.Float64:
+		return reflect.ValueOf(c.value).Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(reflect.ValueOf(c.value).Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(reflect.ValueOf(c.value).Uint()), true
+	}
+	return 0, false
+}
+
+// lessCell orders two cells by value (numbers numerically, times chronologically, bools false first, anything else by text):
+func lessCell(left, right cell) bool {
+	if leftNumber, ok := left.number(); ok {
+		if rightNumber, ok := right.number(); ok {
+			return leftNumber < rightNumber
+		}
+	}
+
+	if leftTime, ok := left.value.(time.Time); ok {
+		if rightTime, ok := right.value.(time.Time); ok {
+			return leftTime.Before(rightTime)
+		}
+	}
+
+	if left.kind == reflect.Bool && right.kind == reflect.Bool {
+		return !reflect.ValueOf(left.value).Bool() && reflect.ValueOf(right.value).Bool()
+	}
+
+	return left.text < right.text
+}
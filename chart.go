@@ -0,0 +1,171 @@
+package tableprinter
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+const (
+	// defaultChartWidth is the width of bars (unless configured otherwise):
+	defaultChartWidth = 10
+)
+
+var (
+	// barBlocks are partial blocks (in eighths) for the ends of bars:
+	barBlocks = []rune("▏▎▍▌▋▊▉█")
+
+	// heatmapColours are 256-colour backgrounds from cold (green) to hot (red):
+	heatmapColours = []int{28, 64, 100, 136, 166, 160}
+
+	// sparklineBlocks are the heights used to draw sparklines:
+	sparklineBlocks = []rune("▁▂▃▄▅▆▇█")
+)
+
+// drawCharts replaces the text of cells in charted columns (keeping their values):
+func (p *Printer) drawCharts(table *Table) *Table {
+	var chartedTable = *table
+
+	chartedTable.rows = nil
+	for _, row := range table.rows {
+		chartedRow := make(tableRow)
+		for header, value := range row {
+			chartedRow.setField(header, value)
+		}
+		chartedTable.addRow(chartedRow)
+	}
+
+	for _, header := range table.headers {
+		switch p.columnConfig(header).Chart {
+		case ChartBar:
+			p.drawBars(&chartedTable, header)
+		case ChartHeatmap:
+			if p.colour {
+				drawHeatmap(&chartedTable, header)
+			}
+		case ChartSparkline:
+			drawSparklines(&chartedTable, header)
+		}
+	}
+
+	return &chartedTable
+}
+
+// drawBars draws a bar in front of each number in a column (in proportion to the largest number):
+func (p *Printer) drawBars(table *Table, header string) {
+	chartWidth := p.columnConfig(header).ChartWidth
+	if chartWidth <= 0 {
+		chartWidth = defaultChartWidth
+	}
+
+	// Scale the bars to the largest number (and line the numbers up after them):
+	_, maximum, ok := columnRange(table, header)
+	if !ok || maximum <= 0 {
+		return
+	}
+	var textWidth int
+	for _, row := range table.rows {
+		if width := displayWidth(row[header].text, p.eastAsianWidth); width > textWidth {
+			textWidth = width
+		}
+	}
+
+	for _, row := range table.rows {
+		number, ok := row[header].number()
+		if !ok {
+			continue
+		}
+		bar := drawBar(math.Max(number, 0)/maximum, chartWidth)
+		text := strings.Repeat(" ", textWidth-displayWidth(row[header].text, p.eastAsianWidth)) + row[header].text
+		row.setField(header, row[header].withText(bar+" "+text))
+	}
+}
+
+// drawBar draws a bar (as a proportion of the full width), padded to the full width:
+func drawBar(proportion float64, width int) string {
+	eighths := int(math.Round(proportion * float64(width*8)))
+	bar := strings.Repeat(string(barBlocks[7]), eighths/8)
+	if eighths%8 > 0 {
+		bar += string(barBlocks[eighths%8-1])
+	}
+	return bar + strings.Repeat(" ", width-displayWidth(bar, false))
+}
+
+// drawHeatmap colours the background of each number in a column (scaled between the smallest and largest numbers):
+func drawHeatmap(table *Table, header string) {
+	minimum, maximum, ok := columnRange(table, header)
+	if !ok {
+		return
+	}
+
+	for _, row := range table.rows {
+		number, ok := row[header].number()
+		if !ok {
+			continue
+		}
+		colourIndex := 0
+		if maximum > minimum {
+			colourIndex = int(math.Round((number - minimum) / (maximum - minimum) * float64(len(heatmapColours)-1)))
+		}
+		row.setField(header, row[header].withText(colourise(fmt.Sprintf(ansiBackground, heatmapColours[colourIndex]), row[header].text)))
+	}
+}
+
+// drawSparklines draws each slice of numbers in a column as a sparkline (scaled to its own smallest and largest numbers):
+func drawSparklines(table *Table, header string) {
+	for _, row := range table.rows {
+		numbers, ok := numberSlice(row[header].value)
+		if !ok {
+			continue
+		}
+		row.setField(header, row[header].withText(drawSparkline(numbers)))
+	}
+}
+
+// drawSparkline draws some numbers as a sparkline:
+func drawSparkline(numbers []float64) string {
+	minimum, maximum := math.Inf(1), math.Inf(-1)
+	for _, number := range numbers {
+		minimum, maximum = math.Min(minimum, number), math.Max(maximum, number)
+	}
+
+	var sparkline []rune
+	for _, number := range numbers {
+		height := 0
+		if maximum > minimum {
+			height = int(math.Round((number - minimum) / (maximum - minimum) * float64(len(sparklineBlocks)-1)))
+		}
+		sparkline = append(sparkline, sparklineBlocks[height])
+	}
+	return string(sparkline)
+}
+
+// columnRange finds the smallest and largest numbers in a column:
+func columnRange(table *Table, header string) (float64, float64, bool) {
+	minimum, maximum, found := math.Inf(1), math.Inf(-1), false
+	for _, row := range table.rows {
+		if number, ok := row[header].number(); ok {
+			minimum, maximum, found = math.Min(minimum, number), math.Max(maximum, number), true
+		}
+	}
+	return minimum, maximum, found
+}
+
+// numberSlice turns a (non-empty) slice of numbers into float64s:
+func numberSlice(value interface{}) ([]float64, bool) {
+	reflectedValue := reflect.ValueOf(value)
+	if (reflectedValue.Kind() != reflect.Slice && reflectedValue.Kind() != reflect.Array) || reflectedValue.Len() == 0 {
+		return nil, false
+	}
+
+	var numbers []float64
+	for i := 0; i < reflectedValue.Len(); i++ {
+		number, ok := newCell(reflectedValue.Index(i).Interface(), "").number()
+		if !ok {
+			return nil, false
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, true
+}
@@ -0,0 +1,38 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+var chartTestRows = []struct {
+	Name    string
+	Load    float64
+	Latency []int
+}{
+	{"cruft-1", 100, []int{1, 5, 9, 5, 1}},
+	{"cruft-2", 45.5, []int{3, 3, 3}},
+	{"cruft-3", 0, nil},
+}
+
+func TestCharts(t *testing.T) {
+	tablePrinter := tableprinter.New().
+		WithColumnConfig("Load", tableprinter.ColumnConfig{Chart: tableprinter.ChartBar, ChartWidth: 4}).
+		WithColumnConfig("Latency", tableprinter.ColumnConfig{Chart: tableprinter.ChartSparkline})
+
+	// Bars and sparklines:
+	marshaledBytes, err := tablePrinter.Marshal(chartTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  LATENCY |   LOAD    |  NAME    \n+---------+-----------+---------+\n  ▁▅█▅▁   | ████  100 | cruft-1  \n  ▁▁▁     | █▉   45.5 | cruft-2  \n  <nil>   |         0 | cruft-3  \n", string(marshaledBytes))
+
+	// Heatmaps need colour:
+	heatmapPrinter := tableprinter.New().WithColumns("Load").WithColumnConfig("Load", tableprinter.ColumnConfig{Chart: tableprinter.ChartHeatmap})
+	marshaledBytes, err = heatmapPrinter.Marshal(chartTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  LOAD  \n+------+\n   100  \n  45.5  \n     0  \n", string(marshaledBytes))
+	marshaledBytes, err = heatmapPrinter.WithColour(true).Marshal(chartTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  LOAD  \n+------+\n   \x1b[48;5;160m100\x1b[0m  \n  \x1b[48;5;100m45.5\x1b[0m  \n     \x1b[48;5;28m0\x1b[0m  \n", string(marshaledBytes))
+}
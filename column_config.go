@@ -0,0 +1,66 @@
+package tableprinter
+
+// Alignment is how values are positioned within a column:
+type Alignment int
+
+const (
+	// AlignAuto aligns values according to their kind (numbers on the right, bools in the centre and anything else on
+	// the left). Headers are centred:
+	AlignAuto Alignment = iota
+
+	// AlignLeft puts values on the left of the column:
+	AlignLeft
+
+	// AlignCenter puts values in the centre of the column:
+	AlignCenter
+
+	// AlignRight puts values on the right of the column:
+	AlignRight
+
+	// AlignDecimal right-aligns numbers with their decimal points lined up (eg "100  " over "  2.25"):
+	AlignDecimal
+)
+
+// Chart is a way of drawing the values in a column:
+type Chart int
+
+const (
+	// ChartNone shows values as text:
+	ChartNone Chart = iota
+
+	// ChartBar draws a bar for each number, in proportion to the largest number in the column:
+	ChartBar
+
+	// ChartHeatmap colours the background of each number, from green (the smallest in the column) to red (the largest).
+	// This needs colour to be enabled (see WithColour):
+	ChartHeatmap
+
+	// ChartSparkline draws slices of numbers as sparklines (eg "▁▃▇"):
+	ChartSparkline
+)
+
+// Wrap is how values which are wider than a column's MaxWidth are broken into lines:
+type Wrap int
+
+const (
+	// WrapWord breaks lines between words (words which are wider than the column are broken wherever they need to be):
+	WrapWord Wrap = iota
+
+	// WrapHard breaks lines at exactly the width of the column:
+	WrapHard
+)
+
+// ColumnConfig configures how a column is rendered (see WithColumnConfig):
+type ColumnConfig struct {
+	Align       Alignment // How to align the values (defaults to AlignAuto)
+	Chart       Chart     // How to draw the values
+	ChartWidth  int       // The width of bars (in characters, defaults to 10)
+	HeaderAlign Alignment // How to align the header (defaults to AlignAuto, which centres it)
+	MaxWidth    int       // The widest a value can be before it is wrapped onto more lines (in characters, 0 for no limit)
+	Wrap        Wrap      // How to wrap values which are wider than MaxWidth (defaults to WrapWord)
+}
+
+// columnConfig returns the configuration for a column (the zero value if it hasn't been configured):
+func (p *Printer) columnConfig(header string) ColumnConfig {
+	return p.columnConfigs[header]
+}
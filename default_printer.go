@@ -1,35 +1,82 @@
 package tableprinter
 
-import "io"
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+)
 
-var defaultTablePrinter *Printer
+var (
+	// defaultTablePrinter holds the current *Printer (which is replaced, never modified, when its configuration changes):
+	defaultTablePrinter atomic.Value
+
+	// defaultTablePrinterMutex makes sure configuration changes don't overwrite each other:
+	defaultTablePrinterMutex sync.Mutex
+)
 
 // init establishes the default printer (which can be used without having to instantiate and maintian a *Printer in-code):
 func init() {
-	defaultTablePrinter = New()
+	defaultTablePrinter.Store(New())
+}
+
+// defaultPrinter returns the current default printer:
+func defaultPrinter() *Printer {
+	return defaultTablePrinter.Load().(*Printer)
+}
+
+// Configure applies options to the default printer (all at once):
+func Configure(options ...Option) {
+	defaultTablePrinterMutex.Lock()
+	defer defaultTablePrinterMutex.Unlock()
+	defaultTablePrinter.Store(defaultPrinter().With(options...))
 }
 
 // Print marshals an interface and prints it to the configured output:
 func Print(value interface{}) error {
-	return defaultTablePrinter.Print(value)
+	return defaultPrinter().Print(value)
 }
 
 // Marshal turns an interface into a text table:
 func Marshal(value interface{}) ([]byte, error) {
-	return defaultTablePrinter.Marshal(value)
+	return defaultPrinter().Marshal(value)
+}
+
+// PrintTree marshals a recursive structure as a tree table and prints it to the configured output:
+func PrintTree(value interface{}, childrenField string) error {
+	return defaultPrinter().PrintTree(value, childrenField)
+}
+
+// MarshalTree turns a recursive structure into a tree table (see Printer.MarshalTree()):
+func MarshalTree(value interface{}, childrenField string) ([]byte, error) {
+	return defaultPrinter().MarshalTree(value, childrenField)
+}
+
+// Diff compares two values and prints a table of the differences to the configured output:
+func Diff(oldValue, newValue interface{}, keyColumns ...string) error {
+	return defaultPrinter().Diff(oldValue, newValue, keyColumns...)
+}
+
+// MarshalDiff compares two values and renders a table of the differences:
+func MarshalDiff(oldValue, newValue interface{}, keyColumns ...string) ([]byte, error) {
+	return defaultPrinter().MarshalDiff(oldValue, newValue, keyColumns...)
+}
+
+// Pivot turns a slice of records into a cross-tab (see Printer.Pivot()):
+func Pivot(value interface{}, rowKey, columnKey, valueField string, aggregate Aggregate) (*Table, error) {
+	return defaultPrinter().Pivot(value, rowKey, columnKey, valueField, aggregate)
 }
 
 // SetBorder configures the default printer with a borders:
 func SetBorder(borders bool) {
-	defaultTablePrinter.borders = borders
+	Configure(WithBorders(borders))
 }
 
 // SetOutput configures the default printer with a specified output:
 func SetOutput(output io.Writer) {
-	defaultTablePrinter.output = output
+	Configure(WithOutput(output))
 }
 
 // SetSortedHeaders configures the default printer to sort columns by their headers:
 func SetSortedHeaders(sortedHeaders bool) {
-	defaultTablePrinter.sortedHeaders = sortedHeaders
+	Configure(WithSortedHeaders(sortedHeaders))
 }
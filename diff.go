@@ -0,0 +1,322 @@
+package tableprinter
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// diffArrow separates the before and after values of a changed cell:
+	diffArrow = " -> "
+
+	// Markers for each kind of row change:
+	diffMarkerAdded     = "+"
+	diffMarkerChanged   = "~"
+	diffMarkerRemoved   = "-"
+	diffMarkerUnchanged = " "
+)
+
+// rowChange describes what happened to a row between two tables:
+type rowChange int
+
+const (
+	rowUnchanged rowChange = iota
+	rowAdded
+	rowChanged
+	rowRemoved
+)
+
+// rowDiff pairs up the old and new versions of a row:
+type rowDiff struct {
+	change        rowChange
+	changedFields map[string]bool
+	newRow        tableRow
+	oldRow        tableRow
+}
+
+// diffTables matches up the rows of two tables (by key columns, or by position without any) and works out what changed.
+// Rows come back in the order of the new table, with removed rows following whichever row preceded them in the old table.
+// Rows with the same key are matched up in the order they appear:
+func diffTables(oldTable, newTable *Table, keyColumns []string) ([]rowDiff, error) {
+	var rowDiffs []rowDiff
+
+	// Key columns have to exist (in at least one of the tables, if there are any rows to compare):
+	for _, keyColumn := range keyColumns {
+		if !oldTable.hasHeader(keyColumn) && !newTable.hasHeader(keyColumn) && len(oldTable.rows)+len(newTable.rows) > 0 {
+			return nil, ErrNoSuchCell
+		}
+	}
+
+	// Index the new rows by key:
+	newRowIndexes := make(map[string]int)
+	for rowIndex, key := range rowKeys(newTable, keyColumns) {
+		newRowIndexes[key] = rowIndex
+	}
+
+	// Find the old version of each new row, and work out where the removed rows belong:
+	oldRows := make(map[int]tableRow)
+	removedRows := make(map[int][]tableRow)
+	precedingRowIndex := -1
+	oldRowKeys := rowKeys(oldTable, keyColumns)
+	for rowIndex, row := range oldTable.rows {
+		if newRowIndex, ok := newRowIndexes[oldRowKeys[rowIndex]]; ok {
+			oldRows[newRowIndex] = row
+			precedingRowIndex = newRowIndex
+			continue
+		}
+		removedRows[precedingRowIndex] = append(removedRows[precedingRowIndex], row)
+	}
+
+	// Put everything together in order:
+	for _, removedRow := range removedRows[-1] {
+		rowDiffs = append(rowDiffs, rowDiff{change: rowRemoved, oldRow: removedRow})
+	}
+	headers := unionHeaders(oldTable, newTable)
+	for rowIndex, newRow := range newTable.rows {
+		rowDiffs = append(rowDiffs, compareRows(oldRows[rowIndex], newRow, headers))
+		for _, removedRow := range removedRows[rowIndex] {
+			rowDiffs = append(rowDiffs, rowDiff{change: rowRemoved, oldRow: removedRow})
+		}
+	}
+
+	return rowDiffs, nil
+}
+
+// rowKeys identifies each row of a table (rows with the same key are told apart by how many came before them):
+func rowKeys(table *Table, keyColumns []string) []string {
+	var keys []string
+	var occurrences = make(map[string]int)
+
+	for rowIndex, row := range table.rows {
+		key := rowKey(row, rowIndex, keyColumns)
+		if occurrences[key] > 0 {
+			keys = append(keys, fmt.Sprintf("%s\x00#%d", key, occurrences[key]))
+		} else {
+			keys = append(keys, key)
+		}
+		occurrences[key]++
+	}
+
+	return keys
+}
+
+// compareRows works out which fields changed between two versions of a row (a missing old row means it was added):
+func compareRows(oldRow, newRow tableRow, headers []string) rowDiff {
+	if oldRow == nil {
+		return rowDiff{change: rowAdded, newRow: newRow}
+	}
+
+	rowDiff := rowDiff{
+		change:        rowUnchanged,
+		changedFields: make(map[string]bool),
+		newRow:        newRow,
+		oldRow:        oldRow,
+	}
+
+	for _, header := range headers {
+		if oldRow[header].text != newRow[header].text {
+			rowDiff.change = rowChanged
+			rowDiff.changedFields[header] = true
+		}
+	}
+
+	return rowDiff
+}
+
+// rowKey identifies a row by the values of its key columns (or by its position if there aren't any):
+func rowKey(row tableRow, rowIndex int, keyColumns []string) string {
+	if len(keyColumns) == 0 {
+		return strconv.Itoa(rowIndex)
+	}
+
+	var keyValues []string
+	for _, keyColumn := range keyColumns {
+		keyValues = append(keyValues, row[keyColumn].text)
+	}
+	return strings.Join(keyValues, "\x00")
+}
+
+// unionHeaders returns the headers of the new table, followed by any which only the old table had:
+func unionHeaders(oldTable, newTable *Table) []string {
+	headers := append([]string{}, newTable.headers...)
+	newHeaders := make(map[string]bool)
+	for _, header := range newTable.headers {
+		newHeaders[header] = true
+	}
+	for _, header := range oldTable.headers {
+		if !newHeaders[header] {
+			headers = append(headers, header)
+		}
+	}
+	return headers
+}
+
+// Diff compares two values and prints a table of the differences to the configured output:
+func (p *Printer) Diff(oldValue, newValue interface{}, keyColumns ...string) error {
+
+	// Marshal the diff to bytes:
+	marshaledBytes, err := p.MarshalDiff(oldValue, newValue, keyColumns...)
+	if err != nil {
+		return err
+	}
+
+	// Now print the marshaled bytes:
+	if _, err := fmt.Fprint(p.output, string(marshaledBytes)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalDiff compares two values (matching rows by key columns, or by position without any) and renders the differences:
+func (p *Printer) MarshalDiff(oldValue, newValue interface{}, keyColumns ...string) ([]byte, error) {
+
+	// Turn both values into tables:
+	oldTable, err := p.tableFromValue(oldValue)
+	if err != nil {
+		return nil, err
+	}
+	newTable, err := p.tableFromValue(newValue)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := unionHeaders(oldTable, newTable)
+	rowDiffs, err := diffTables(oldTable, newTable, keyColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case p.colour:
+		return p.wrapCells(diffTableWithColour(headers, rowDiffs)).bytes(p)
+	case p.unifiedDiff:
+		return p.renderUnifiedDiff(headers, rowDiffs)
+	default:
+		return p.wrapCells(diffTableWithMarkers(headers, rowDiffs)).bytes(p)
+	}
+}
+
+// diffTableWithColour shows added rows in green, removed rows in red, and the before / after values of changed cells:
+func diffTableWithColour(headers []string, rowDiffs []rowDiff) *Table {
+	var diffTable = &Table{headers: headers}
+
+	for _, rowDiff := range rowDiffs {
+		var row = make(tableRow)
+
+		for _, header := range headers {
+			switch {
+			case rowDiff.change == rowAdded:
+				row[header] = rowDiff.newRow[header].withText(colourise(ansiGreen, rowDiff.newRow[header].text))
+			case rowDiff.change == rowRemoved:
+				row[header] = rowDiff.oldRow[header].withText(colourise(ansiRed, rowDiff.oldRow[header].text))
+			case rowDiff.changedFields[header]:
+				row[header] = textCell(colourise(ansiRed, rowDiff.oldRow[header].text) + diffArrow + colourise(ansiGreen, rowDiff.newRow[header].text))
+			default:
+				row[header] = rowDiff.newRow[header]
+			}
+		}
+
+		diffTable.addRow(row)
+	}
+
+	return diffTable
+}
+
+// diffTableWithMarkers adds a leading column of +/-/~ markers, and shows the before / after values of changed cells:
+func diffTableWithMarkers(headers []string, rowDiffs []rowDiff) *Table {
+	var diffTable = &Table{headers: append([]string{""}, headers...)}
+
+	for _, rowDiff := range rowDiffs {
+		var row = make(tableRow)
+
+		for _, header := range headers {
+			switch {
+			case rowDiff.change == rowRemoved:
+				row[header] = rowDiff.oldRow[header]
+			case rowDiff.changedFields[header]:
+				row[header] = textCell(rowDiff.oldRow[header].text + diffArrow + rowDiff.newRow[header].text)
+			default:
+				row[header] = rowDiff.newRow[header]
+			}
+		}
+		row[""] = textCell(diffMarker(rowDiff.change))
+
+		diffTable.addRow(row)
+	}
+
+	return diffTable
+}
+
+// renderUnifiedDiff renders the old and new versions of every row in one table, then marks each line like a unified diff:
+func (p *Printer) renderUnifiedDiff(headers []string, rowDiffs []rowDiff) ([]byte, error) {
+	var diffTable = &Table{headers: headers}
+	var rowMarkers []string
+
+	// Changed rows appear twice (before and after):
+	for _, rowDiff := range rowDiffs {
+		switch rowDiff.change {
+		case rowAdded:
+			diffTable.addRow(rowDiff.newRow)
+			rowMarkers = append(rowMarkers, diffMarkerAdded)
+		case rowRemoved:
+			diffTable.addRow(rowDiff.oldRow)
+			rowMarkers = append(rowMarkers, diffMarkerRemoved)
+		case rowChanged:
+			diffTable.addRow(rowDiff.oldRow)
+			diffTable.addRow(rowDiff.newRow)
+			rowMarkers = append(rowMarkers, diffMarkerRemoved, diffMarkerAdded)
+		default:
+			diffTable.addRow(rowDiff.newRow)
+			rowMarkers = append(rowMarkers, diffMarkerUnchanged)
+		}
+	}
+
+	// Wrap long values first (so we know how many lines each row takes up):
+	diffTable = p.wrapCells(diffTable)
+	tableBytes, err := diffTable.bytes(p)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.TrimSuffix(string(tableBytes), "\n"), "\n")
+
+	// Mark the lines of each row (rows can span several lines), leaving the header and borders unmarked:
+	unifiedBuffer := bytes.NewBuffer(nil)
+	lineIndex := 0
+	for _, line := range lines[:diffTable.headerLines(p.borders)] {
+		fmt.Fprintln(unifiedBuffer, diffMarkerUnchanged+line)
+		lineIndex++
+	}
+	spacing := p.rowSpacing()
+	for rowIndex, row := range diffTable.rows {
+		for rowLine := 0; rowLine < row.height(); rowLine++ {
+			fmt.Fprintln(unifiedBuffer, rowMarkers[rowIndex]+lines[lineIndex])
+			lineIndex++
+		}
+		for separatorLine := 0; separatorLine < spacing.linesAfter(rowIndex, len(diffTable.rows)); separatorLine++ {
+			fmt.Fprintln(unifiedBuffer, diffMarkerUnchanged+lines[lineIndex])
+			lineIndex++
+		}
+	}
+	for _, line := range lines[lineIndex:] {
+		fmt.Fprintln(unifiedBuffer, diffMarkerUnchanged+line)
+	}
+
+	return unifiedBuffer.Bytes(), nil
+}
+
+// diffMarker returns the marker for a kind of row change:
+func diffMarker(change rowChange) string {
+	switch change {
+	case rowAdded:
+		return diffMarkerAdded
+	case rowChanged:
+		return diffMarkerChanged
+	case rowRemoved:
+		return diffMarkerRemoved
+	default:
+		return diffMarkerUnchanged
+	}
+}
@@ -0,0 +1,88 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+type diffTestCase struct {
+	expectedOutput string
+	keyColumns     []string
+	tablePrinter   *tableprinter.Printer
+}
+
+var (
+	diffTestOldValue = []struct {
+		Name   string
+		Weight int
+	}{
+		{"cruft-1", 1},
+		{"cruft-2", 2},
+		{"cruft-3", 3},
+	}
+
+	diffTestNewValue = []struct {
+		Name   string
+		Weight int
+	}{
+		{"cruft-1", 1},
+		{"cruft-3", 33},
+		{"cruft-4", 4},
+	}
+
+	diffTests = map[string]diffTestCase{
+		"Markers": {
+			tablePrinter:   tableprinter.New(),
+			keyColumns:     []string{"Name"},
+			expectedOutput: "    |  NAME   | WEIGHT   \n+---+---------+---------+\n    | cruft-1 |       1  \n  - | cruft-2 |       2  \n  ~ | cruft-3 | 3 -> 33  \n  + | cruft-4 |       4  \n",
+		},
+		"Markers by position": {
+			tablePrinter:   tableprinter.New(),
+			expectedOutput: "    |        NAME        | WEIGHT   \n+---+--------------------+---------+\n    | cruft-1            |       1  \n  ~ | cruft-2 -> cruft-3 | 2 -> 33  \n  ~ | cruft-3 -> cruft-4 | 3 -> 4   \n",
+		},
+		"Colour": {
+			tablePrinter:   tableprinter.New().WithColour(true),
+			keyColumns:     []string{"Name"},
+			expectedOutput: "   NAME   | WEIGHT   \n+---------+---------+\n  cruft-1 |       1  \n  \x1b[31mcruft-2\x1b[0m | \x1b[31m2\x1b[0m        \n  cruft-3 | \x1b[31m3\x1b[0m -> \x1b[32m33\x1b[0m  \n  \x1b[32mcruft-4\x1b[0m | \x1b[32m4\x1b[0m        \n",
+		},
+		"Unified": {
+			tablePrinter:   tableprinter.New().WithUnifiedDiff(true),
+			keyColumns:     []string{"Name"},
+			expectedOutput: "    NAME   | WEIGHT  \n +---------+--------+\n   cruft-1 |      1  \n-  cruft-2 |      2  \n-  cruft-3 |      3  \n+  cruft-3 |     33  \n+  cruft-4 |      4  \n",
+		},
+		"Unified with borders": {
+			tablePrinter:   tableprinter.New().WithUnifiedDiff(true).WithBorders(true),
+			keyColumns:     []string{"Name"},
+			expectedOutput: " +---------+--------+\n |  NAME   | WEIGHT |\n +---------+--------+\n | cruft-1 |      1 |\n-| cruft-2 |      2 |\n-| cruft-3 |      3 |\n+| cruft-3 |     33 |\n+| cruft-4 |      4 |\n +---------+--------+\n",
+		},
+	}
+)
+
+func TestDiff(t *testing.T) {
+	for name, tc := range diffTests {
+
+		// Run the test with its own name:
+		t.Run(name, func(t *testing.T) {
+			diffBytes, err := tc.tablePrinter.MarshalDiff(diffTestOldValue, diffTestNewValue, tc.keyColumns...)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedOutput, string(diffBytes))
+		})
+	}
+}
+
+func TestDiffKeys(t *testing.T) {
+	tablePrinter := tableprinter.New()
+
+	// Key columns have to exist:
+	_, err := tablePrinter.MarshalDiff(diffTestOldValue, diffTestNewValue, "Nmae")
+	assert.Equal(t, tableprinter.ErrNoSuchCell, err)
+
+	// Rows with the same key are matched up in order:
+	oldValue := []struct{ Name, Zone string }{{"cruft", "a"}, {"cruft", "b"}}
+	newValue := []struct{ Name, Zone string }{{"cruft", "a"}, {"cruft", "c"}, {"cruft", "d"}}
+	diffBytes, err := tablePrinter.MarshalDiff(oldValue, newValue, "Name")
+	assert.NoError(t, err)
+	assert.Equal(t, "    | NAME  |  ZONE   \n+---+-------+--------+\n    | cruft | a       \n  ~ | cruft | b -> c  \n  + | cruft | d       \n", string(diffBytes))
+}
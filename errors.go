@@ -3,6 +3,15 @@ package tableprinter
 import "fmt"
 
 var (
-	ErrAssertion = fmt.Errorf("Unable to assert value")
-	ErrNoData    = fmt.Errorf("No data to render")
+	ErrAssertion        = fmt.Errorf("Unable to assert value")
+	ErrDuplicateColumn  = fmt.Errorf("Column already exists")
+	ErrInvalidInterval  = fmt.Errorf("Interval must be positive")
+	ErrInvalidPath      = fmt.Errorf("Invalid path expression")
+	ErrInvalidTarget    = fmt.Errorf("Unable to unmarshal into this type")
+	ErrNoData           = fmt.Errorf("No data to render")
+	ErrNoMatchingFields = fmt.Errorf("No headers match the fields of the target")
+	ErrNoSuchCell       = fmt.Errorf("No such cell")
+	ErrNoTerminal       = fmt.Errorf("Not a terminal")
+	ErrPathNotFound     = fmt.Errorf("Path not found in value")
+	ErrTooManyValues    = fmt.Errorf("More values than columns")
 )
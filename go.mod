@@ -4,7 +4,6 @@ go 1.12
 
 require (
 	github.com/davecgh/go-spew v1.1.0
-	github.com/mattn/go-runewidth v0.0.4 // indirect
-	github.com/olekukonko/tablewriter v0.0.1
+	github.com/mattn/go-runewidth v0.0.4
 	github.com/stretchr/testify v1.3.0
 )
@@ -0,0 +1,73 @@
+package tableprinter
+
+import (
+	"sort"
+)
+
+const (
+	// subtotalLabel goes in the group column of subtotal rows:
+	subtotalLabel = "subtotal"
+)
+
+// isSubtotalRow determines whether a row was added by groupRows (rather than coming from the data):
+func (p *Printer) isSubtotalRow(row tableRow) bool {
+	return p.groupBy != "" && row[p.groupBy].value == nil && row[p.groupBy].text == subtotalLabel
+}
+
+// groupRows sorts rows into groups (by the values in one column), which becomes the leading column. The group value is
+// only shown on the first row of each group (the rest are merged with it), and each group can be followed by a row of
+// subtotals:
+func (p *Printer) groupRows(table *Table) (*Table, error) {
+	if !table.hasHeader(p.groupBy) {
+		return nil, ErrNoSuchCell
+	}
+	if err := table.checkAggregates(p.subtotals); err != nil {
+		return nil, err
+	}
+
+	// The group column comes first:
+	var groupedTable = *table
+	groupedTable.headers = []string{p.groupBy}
+	groupedTable.rows = nil
+	for _, header := range table.headers {
+		if header != p.groupBy {
+			groupedTable.addHeader(header)
+		}
+	}
+
+	// Sort the rows by group (without changing the order of rows within a group):
+	var sortedRows = append([]tableRow{}, table.rows...)
+	sort.SliceStable(sortedRows, func(i, j int) bool {
+		return lessCell(sortedRows[i][p.groupBy], sortedRows[j][p.groupBy])
+	})
+
+	// Add each group in turn:
+	for groupStart := 0; groupStart < len(sortedRows); {
+		groupEnd := groupStart + 1
+		for groupEnd < len(sortedRows) && sortedRows[groupEnd][p.groupBy].text == sortedRows[groupStart][p.groupBy].text {
+			groupEnd++
+		}
+		groupRows := sortedRows[groupStart:groupEnd]
+
+		for rowIndex, row := range groupRows {
+			groupedRow := make(tableRow)
+			for header, value := range row {
+				groupedRow.setField(header, value)
+			}
+			if rowIndex > 0 {
+				groupedRow.setField(p.groupBy, row[p.groupBy].withMerged(true))
+			}
+			groupedTable.addRow(groupedRow)
+		}
+
+		if len(p.subtotals) > 0 {
+			subtotalRow := p.aggregateRow(groupRows, p.subtotals)
+			subtotalRow.setField(p.groupBy, textCell(subtotalLabel))
+			groupedTable.addRow(subtotalRow)
+		}
+
+		groupStart = groupEnd
+	}
+
+	return &groupedTable, nil
+}
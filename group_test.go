@@ -0,0 +1,55 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+var groupTestRows = []struct {
+	Customer string
+	Region   string
+	Amount   int
+}{
+	{"cruft-1", "us", 5},
+	{"cruft-2", "eu", 12},
+	{"cruft-3", "us", 7},
+	{"cruft-4", "apac", 3},
+	{"cruft-5", "eu", 1},
+}
+
+func TestGroupBy(t *testing.T) {
+	tablePrinter := tableprinter.New().WithGroupBy("Region")
+
+	// Groups are sorted, and keep their rows in order:
+	marshaledBytes, err := tablePrinter.Marshal(groupTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  REGION | AMOUNT | CUSTOMER  \n+--------+--------+----------+\n  apac   |      3 | cruft-4   \n  eu     |     12 | cruft-2   \n         |      1 | cruft-5   \n  us     |      5 | cruft-1   \n         |      7 | cruft-3   \n", string(marshaledBytes))
+
+	// Subtotals follow each group (and footers still cover every row):
+	marshaledBytes, err = tablePrinter.
+		WithSubtotals(map[string]tableprinter.Aggregate{"Amount": tableprinter.Sum}).
+		WithFooter(map[string]tableprinter.Aggregate{"Amount": tableprinter.Sum, "Customer": tableprinter.Count}).
+		Marshal(groupTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "   REGION  | AMOUNT | CUSTOMER  \n+----------+--------+----------+\n  apac     |      3 | cruft-4   \n  subtotal |      3 |           \n  eu       |     12 | cruft-2   \n           |      1 | cruft-5   \n  subtotal |     13 |           \n  us       |      5 | cruft-1   \n           |      7 | cruft-3   \n  subtotal |     12 |           \n+----------+--------+----------+\n                 28 |        5  \n           +--------+----------+\n", string(marshaledBytes))
+
+	// Groups are merged cells (so row separators are left open across them):
+	marshaledBytes, err = tablePrinter.WithRowSeparators(true).Marshal(groupTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  REGION | AMOUNT | CUSTOMER  \n+--------+--------+----------+\n  apac   |      3 | cruft-4   \n+--------+--------+----------+\n  eu     |     12 | cruft-2   \n|        +--------+----------+\n         |      1 | cruft-5   \n+--------+--------+----------+\n  us     |      5 | cruft-1   \n|        +--------+----------+\n         |      7 | cruft-3   \n", string(marshaledBytes))
+
+	// And other columns can be merged within groups:
+	marshaledBytes, err = tablePrinter.WithMergedColumns("Region", "Customer").Marshal([]struct{ Region, Customer string }{{"eu", "cruft-1"}, {"eu", "cruft-1"}, {"us", "cruft-1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "  REGION | CUSTOMER  \n+--------+----------+\n  eu     | cruft-1   \n         |           \n  us     | cruft-1   \n", string(marshaledBytes))
+
+	// Grouping by a column which doesn't exist:
+	_, err = tablePrinter.WithGroupBy("Country").Marshal(groupTestRows)
+	assert.Equal(t, tableprinter.ErrNoSuchCell, err)
+
+	// Or with subtotals for a column which doesn't exist:
+	_, err = tablePrinter.WithSubtotals(map[string]tableprinter.Aggregate{"Nope": tableprinter.Sum}).Marshal(groupTestRows)
+	assert.Equal(t, tableprinter.ErrNoSuchCell, err)
+}
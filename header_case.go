@@ -0,0 +1,103 @@
+package tableprinter
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HeaderCase is how headers (and header groups) are displayed:
+type HeaderCase int
+
+const (
+	// HeaderUpper upper-cases headers, with "_" and "." between words replaced by spaces (eg "FAVOURITEWORDS" or
+	// "FAVOURITE WORDS" from "favourite_words"). This is the default:
+	HeaderUpper HeaderCase = iota
+
+	// HeaderPreserve displays headers exactly as they are:
+	HeaderPreserve
+
+	// HeaderTitle capitalises each word, with "_" and "." between words replaced by spaces (eg "Favourite Words" from
+	// "favourite_words"):
+	HeaderTitle
+
+	// HeaderHumanised splits CamelCase and snake_case into capitalised words, keeping acronyms together (eg
+	// "Favourite Words" from "FavouriteWords", and "HTTP Status" from "HTTPStatus"):
+	HeaderHumanised
+)
+
+// format displays a header in this case:
+func (c HeaderCase) format(header string) string {
+	switch c {
+	case HeaderPreserve:
+		return header
+	case HeaderTitle:
+		return capitaliseWords(separateWords(header))
+	case HeaderHumanised:
+		return capitaliseWords(splitCamelCase(separateWords(header)))
+	default:
+		return strings.ToUpper(separateWords(header))
+	}
+}
+
+// separateWords replaces "_" (and "." between words) with spaces:
+func separateWords(name string) string {
+	runes := []rune(name)
+	for runeIndex, r := range runes {
+		switch r {
+		case '_':
+			runes[runeIndex] = ' '
+		case '.':
+			// Decimal points stay as they are:
+			if (runeIndex != 0 && !isDigitOrSpace(runes[runeIndex-1])) || (runeIndex != len(runes)-1 && !isDigitOrSpace(runes[runeIndex+1])) {
+				runes[runeIndex] = ' '
+			}
+		}
+	}
+
+	separated := strings.TrimSpace(string(runes))
+	if separated == "" && name != "" {
+		separated = " "
+	}
+	return separated
+}
+
+// splitCamelCase puts spaces between the words of CamelCase names. Runs of capitals are kept together as acronyms,
+// with the last one starting the next word (eg "HTTP Status" from "HTTPStatus"):
+func splitCamelCase(name string) string {
+	var split strings.Builder
+	runes := []rune(name)
+
+	for runeIndex, r := range runes {
+		if runeIndex > 0 && unicode.IsUpper(r) {
+			previous := runes[runeIndex-1]
+			startsAcronym := unicode.IsLower(previous) || unicode.IsDigit(previous)
+			endsAcronym := unicode.IsUpper(previous) && runeIndex+1 < len(runes) && unicode.IsLower(runes[runeIndex+1])
+			if startsAcronym || endsAcronym {
+				split.WriteRune(' ')
+			}
+		}
+		split.WriteRune(r)
+	}
+
+	return split.String()
+}
+
+// capitaliseWords upper-cases the first letter of each word (collapsing runs of spaces):
+func capitaliseWords(text string) string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return text
+	}
+
+	for wordIndex, word := range words {
+		firstRune, size := utf8.DecodeRuneInString(word)
+		words[wordIndex] = string(unicode.ToUpper(firstRune)) + word[size:]
+	}
+	return strings.Join(words, " ")
+}
+
+// isDigitOrSpace determines whether a rune is a digit or a space:
+func isDigitOrSpace(r rune) bool {
+	return ('0' <= r && r <= '9') || r == ' '
+}
@@ -0,0 +1,51 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+var headerCaseTestRows = []struct {
+	FavouriteWords string
+	HTTPStatus     int
+	UserID         string
+	Snake_Case     bool
+}{
+	{"cruft", 200, "prawn", true},
+}
+
+func TestHeaderCase(t *testing.T) {
+	tablePrinter := tableprinter.New().WithSortedHeaders(false)
+
+	// Upper case by default:
+	marshaledBytes, err := tablePrinter.Marshal(headerCaseTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  FAVOURITEWORDS | HTTPSTATUS | USERID | SNAKE CASE  \n+----------------+------------+--------+------------+\n  cruft          |        200 | prawn  |    true     \n", string(marshaledBytes))
+
+	// Preserved:
+	marshaledBytes, err = tablePrinter.WithHeaderCase(tableprinter.HeaderPreserve).Marshal(headerCaseTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  FavouriteWords | HTTPStatus | UserID | Snake_Case  \n+----------------+------------+--------+------------+\n  cruft          |        200 | prawn  |    true     \n", string(marshaledBytes))
+
+	// Title Case:
+	marshaledBytes, err = tablePrinter.WithHeaderCase(tableprinter.HeaderTitle).Marshal(headerCaseTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  FavouriteWords | HTTPStatus | UserID | Snake Case  \n+----------------+------------+--------+------------+\n  cruft          |        200 | prawn  |    true     \n", string(marshaledBytes))
+
+	// Humanised:
+	marshaledBytes, err = tablePrinter.WithHeaderCase(tableprinter.HeaderHumanised).Marshal(headerCaseTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  Favourite Words | HTTP Status | User ID | Snake Case  \n+-----------------+-------------+---------+------------+\n  cruft           |         200 | prawn   |    true     \n", string(marshaledBytes))
+
+	// Title Case (from snake_case):
+	marshaledBytes, err = tablePrinter.WithHeaderCase(tableprinter.HeaderTitle).Marshal(map[string]interface{}{"favourite_words": "cruft"})
+	assert.NoError(t, err)
+	assert.Equal(t, "  Favourite Words  \n+-----------------+\n  cruft            \n", string(marshaledBytes))
+
+	// Footers hold values, so they are never changed:
+	marshaledBytes, err = tablePrinter.WithHeaderCase(tableprinter.HeaderHumanised).WithFooter(map[string]tableprinter.Aggregate{"UserID": tableprinter.Max}).Marshal([]struct{ UserID string }{{"fooBar"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "  User ID  \n+---------+\n  fooBar   \n+---------+\n   fooBar  \n+---------+\n", string(marshaledBytes))
+}
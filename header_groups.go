@@ -0,0 +1,34 @@
+package tableprinter
+
+// headerSpan is a run of adjacent columns in the same header group (or a single column without one):
+type headerSpan struct {
+	firstColumn int
+	lastColumn  int
+	group       string
+}
+
+// hasHeaderGroups determines whether any of the columns are in a header group:
+func (t *Table) hasHeaderGroups() bool {
+	for _, header := range t.headers {
+		if t.headerGroups[header] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// headerSpans splits the columns into runs which share a header group:
+func (t *Table) headerSpans() []headerSpan {
+	var spans []headerSpan
+
+	for columnIndex, header := range t.headers {
+		group := t.headerGroups[header]
+		if len(spans) > 0 && group != "" && spans[len(spans)-1].group == group {
+			spans[len(spans)-1].lastColumn = columnIndex
+			continue
+		}
+		spans = append(spans, headerSpan{firstColumn: columnIndex, lastColumn: columnIndex, group: group})
+	}
+
+	return spans
+}
@@ -0,0 +1,62 @@
+package tableprinter_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+type headerGroupTestResources struct {
+	CPU    int
+	Memory string
+}
+
+type headerGroupTestRow struct {
+	Name      string
+	Resources headerGroupTestResources
+	Limits    *headerGroupTestResources
+	Created   time.Time
+}
+
+var headerGroupTestRows = []headerGroupTestRow{
+	{"cruft-1", headerGroupTestResources{2, "1Gi"}, &headerGroupTestResources{4, "2Gi"}, time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)},
+	{"cruft-2", headerGroupTestResources{1, "512Mi"}, nil, time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)},
+}
+
+func TestFlattenedStructs(t *testing.T) {
+	tablePrinter := tableprinter.New().WithFlattenedStructs(true).WithSortedHeaders(false)
+
+	// Nested structs get a header spanning their fields (structs with String() methods are left alone):
+	marshaledBytes, err := tablePrinter.Marshal(headerGroupTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "          |  RESOURCES   |     LIMITS     |                                \n   NAME   | CPU | MEMORY |  CPU  | MEMORY |            CREATED             \n+---------+-----+--------+-------+--------+-------------------------------+\n  cruft-1 |   2 | 1Gi    |     4 | 2Gi    | 2019-01-02 03:04:05 +0000 UTC  \n  cruft-2 |   1 | 512Mi  | <nil> | <nil>  | 2019-01-02 03:04:05 +0000 UTC  \n", string(marshaledBytes))
+
+	// Borders go around the groups too:
+	marshaledBytes, err = tablePrinter.WithBorders(true).Marshal(headerGroupTestRows[:1])
+	assert.NoError(t, err)
+	assert.Equal(t, "+---------+--------------+--------------+-------------------------------+\n|         |  RESOURCES   |    LIMITS    |                               |\n+---------+-----+--------+-----+--------+-------------------------------+\n|  NAME   | CPU | MEMORY | CPU | MEMORY |            CREATED            |\n+---------+-----+--------+-----+--------+-------------------------------+\n| cruft-1 |   2 | 1Gi    |   4 | 2Gi    | 2019-01-02 03:04:05 +0000 UTC |\n+---------+-----+--------+-----+--------+-------------------------------+\n", string(marshaledBytes))
+}
+
+func TestSetHeaderGroup(t *testing.T) {
+	table, err := tableprinter.NewTable("Name", "Requests", "Limits", "Weight")
+	assert.NoError(t, err)
+	assert.NoError(t, table.AddRow("cruft-1", 1, 2, 5))
+	assert.NoError(t, table.SetHeaderGroup("CPU", "Requests", "Limits"))
+	assert.Equal(t, tableprinter.ErrNoSuchCell, table.SetHeaderGroup("CPU", "Cruftiness"))
+
+	renderedBytes, err := tableprinter.New().Render(table)
+	assert.NoError(t, err)
+	assert.Equal(t, "          |        CPU        |         \n   NAME   | REQUESTS | LIMITS | WEIGHT  \n+---------+----------+--------+--------+\n  cruft-1 |        1 |      2 |      5  \n", string(renderedBytes))
+}
+
+func TestPagerHeaderGroups(t *testing.T) {
+	terminal := &fakeTerminal{keyPresses: strings.NewReader("q")}
+
+	// The group headers stay at the top of the screen too:
+	err := tableprinter.New().WithFlattenedStructs(true).WithTerminal(terminal).Page(headerGroupTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "                                |     LI\r\n             CREATED            |  CPU  \r\n+-------------------------------+-------\r\n  2019-01-02 03:04:05 +0000 UTC |     4 \r\n  2019-01-02 03:04:05 +0000 UTC | <nil> \r\n\x1b[7m column: Created | </>:column s:sort x:h", terminal.lastScreen())
+}
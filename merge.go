@@ -0,0 +1,39 @@
+package tableprinter
+
+// mergeCells merges runs of identical values in the configured columns, so each value is only shown once (at the top of
+// its run). Merged columns are hierarchical: a cell is only merged if the merged columns to its left were merged too:
+func (p *Printer) mergeCells(table *Table) *Table {
+	var mergedTable = *table
+	var mergedHeaders []string
+
+	// Work out which columns to merge (in the order they are displayed):
+	var mergedColumns = make(map[string]bool)
+	for _, header := range p.mergedColumns {
+		mergedColumns[header] = true
+	}
+	for _, header := range table.headers {
+		if p.mergeAllCells || mergedColumns[header] {
+			mergedHeaders = append(mergedHeaders, header)
+		}
+	}
+
+	mergedTable.rows = nil
+	for rowIndex, row := range table.rows {
+		mergedRow := make(tableRow)
+		for header, value := range row {
+			mergedRow.setField(header, value)
+		}
+
+		// Merge cells with the one above, until we find a different value:
+		for _, header := range mergedHeaders {
+			if rowIndex == 0 || row[header].text == "" || row[header].text != table.rows[rowIndex-1][header].text {
+				break
+			}
+			mergedRow.setField(header, row[header].withMerged(true))
+		}
+
+		mergedTable.addRow(mergedRow)
+	}
+
+	return &mergedTable
+}
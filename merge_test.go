@@ -0,0 +1,39 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+var mergeTestRows = []struct {
+	Namespace string
+	Pod       string
+	Container string
+}{
+	{"default", "cruft-1", "app"},
+	{"default", "cruft-1", "sidecar"},
+	{"default", "cruft-2", "app"},
+	{"system", "cruft-2", "app"},
+	{"system", "cruft-2", "sidecar"},
+}
+
+func TestMergedColumns(t *testing.T) {
+	tablePrinter := tableprinter.New().WithSortedHeaders(false)
+
+	// Only the chosen column is merged:
+	marshaledBytes, err := tablePrinter.WithMergedColumns("Namespace").Marshal(mergeTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  NAMESPACE |   POD   | CONTAINER  \n+-----------+---------+-----------+\n  default   | cruft-1 | app        \n            | cruft-1 | sidecar    \n            | cruft-2 | app        \n  system    | cruft-2 | app        \n            | cruft-2 | sidecar    \n", string(marshaledBytes))
+
+	// Merges are hierarchical (a pod in a new namespace is shown again):
+	marshaledBytes, err = tablePrinter.WithBorders(true).WithMergedColumns("Namespace", "Pod").Marshal(mergeTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "+-----------+---------+-----------+\n| NAMESPACE |   POD   | CONTAINER |\n+-----------+---------+-----------+\n| default   | cruft-1 | app       |\n|           |         | sidecar   |\n|           | cruft-2 | app       |\n| system    | cruft-2 | app       |\n|           |         | sidecar   |\n+-----------+---------+-----------+\n", string(marshaledBytes))
+
+	// Every column can be merged:
+	marshaledBytes, err = tablePrinter.WithMergedCells(true).Marshal(mergeTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  NAMESPACE |   POD   | CONTAINER  \n+-----------+---------+-----------+\n  default   | cruft-1 | app        \n            |         | sidecar    \n            | cruft-2 | app        \n  system    | cruft-2 | app        \n            |         | sidecar    \n", string(marshaledBytes))
+}
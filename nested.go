@@ -0,0 +1,106 @@
+package tableprinter
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	// nestedDetailIndent indents detail blocks under the row they belong to:
+	nestedDetailIndent = "    "
+)
+
+// isNestableValue determines whether a value is a (non-empty) slice of structs (or pointers to structs):
+func isNestableValue(value interface{}) bool {
+	reflectedValue := reflect.ValueOf(value)
+	return reflectedValue.Kind() == reflect.Slice && reflectedValue.Len() > 0 && isStructType(reflectedValue.Type().Elem())
+}
+
+// nestedPrinter renders nested tables (with borders, and one less level of nesting available):
+func (p *Printer) nestedPrinter() *Printer {
+	return New(
+		WithBorders(true),
+		WithFlattenedStructs(p.flattenStructs),
+		WithHeaderCase(p.headerCase),
+		WithNestedDetails(p.nestedDetails),
+		WithNestedTables(p.nestedTables-1),
+		WithSortedHeaders(p.sortedHeaders),
+	)
+}
+
+// nestTables renders cells holding slices of structs as tables. They either replace the cell's text, or are returned
+// (by row index) to be shown as detail blocks under their rows:
+func (p *Printer) nestTables(table *Table) (*Table, map[int]string, error) {
+	var nestedTable = *table
+	var details = make(map[int]string)
+
+	nestedTable.rows = nil
+	for rowIndex, row := range table.rows {
+		nestedRow := make(tableRow)
+		for header, value := range row {
+			nestedRow.setField(header, value)
+		}
+
+		for _, header := range table.headers {
+			if !isNestableValue(row[header].value) {
+				continue
+			}
+
+			renderedBytes, err := p.nestedPrinter().Marshal(row[header].value)
+			if err != nil {
+				return nil, nil, err
+			}
+			renderedTable := strings.TrimSuffix(string(renderedBytes), "\n")
+
+			// Detail blocks go under the row (leaving a summary in the cell):
+			if p.nestedDetails {
+				nestedRow.setField(header, row[header].withText(rowCount(reflect.ValueOf(row[header].value).Len())))
+				details[rowIndex] += indentLines(table.headerLabel(header)+":\n"+renderedTable, nestedDetailIndent)
+				continue
+			}
+
+			nestedRow.setField(header, row[header].withText(renderedTable))
+		}
+
+		nestedTable.addRow(nestedRow)
+	}
+
+	return &nestedTable, details, nil
+}
+
+// addDetails inserts detail blocks after the rows they belong to in a rendered table:
+func (t *Table) addDetails(renderedTable []byte, p *Printer, details map[int]string) []byte {
+	var renderedLines = strings.SplitAfter(string(renderedTable), "\n")
+	var linesWithDetails []string
+	var spacing = p.rowSpacing()
+
+	// Copy the header, then each row (followed by its details, then whatever separates it from the next row):
+	lineIndex := t.headerLines(p.borders)
+	linesWithDetails = append(linesWithDetails, renderedLines[:lineIndex]...)
+	for rowIndex, row := range t.rows {
+		linesWithDetails = append(linesWithDetails, renderedLines[lineIndex:lineIndex+row.height()]...)
+		linesWithDetails = append(linesWithDetails, details[rowIndex])
+		lineIndex += row.height()
+
+		linesAfter := spacing.linesAfter(rowIndex, len(t.rows))
+		linesWithDetails = append(linesWithDetails, renderedLines[lineIndex:lineIndex+linesAfter]...)
+		lineIndex += linesAfter
+	}
+	linesWithDetails = append(linesWithDetails, renderedLines[lineIndex:]...)
+
+	return []byte(strings.Join(linesWithDetails, ""))
+}
+
+// rowCount summarises how many rows a nested table has:
+func rowCount(rows int) string {
+	if rows == 1 {
+		return "<1 row>"
+	}
+	return fmt.Sprintf("<%d rows>", rows)
+}
+
+// indentLines indents every line of some text (making sure it ends with a newline):
+func indentLines(text, indent string) string {
+	return indent + strings.Replace(text, "\n", "\n"+indent, -1) + "\n"
+}
@@ -0,0 +1,35 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+type nestedTestPart struct {
+	Name  string
+	Parts []nestedTestPart
+}
+
+var nestedTestRows = []struct {
+	Name  string
+	Parts []nestedTestPart
+}{
+	{"cruft-1", []nestedTestPart{{"part-1", nil}, {"part-2", []nestedTestPart{{"part-3", nil}}}}},
+	{"cruft-2", nil},
+}
+
+func TestNestedTables(t *testing.T) {
+	tablePrinter := tableprinter.New()
+
+	// Nested tables go inside their cells (and stop at the depth limit):
+	marshaledBytes, err := tablePrinter.WithNestedTables(1).Marshal(nestedTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "   NAME   |             PARTS              \n+---------+-------------------------------+\n  cruft-1 | +--------+------------------+  \n          | |  NAME  |      PARTS       |  \n          | +--------+------------------+  \n          | | part-1 | <nil>            |  \n          | | part-2 | [{part-3 <nil>}] |  \n          | +--------+------------------+  \n  cruft-2 | <nil>                          \n", string(marshaledBytes))
+
+	// Or under their rows:
+	marshaledBytes, err = tablePrinter.WithNestedTables(2).WithNestedDetails(true).Marshal(nestedTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "   NAME   |  PARTS    \n+---------+----------+\n  cruft-1 | <2 rows>  \n    Parts:\n    +--------+---------+\n    |  NAME  |  PARTS  |\n    +--------+---------+\n    | part-1 | <nil>   |\n    | part-2 | <1 row> |\n        Parts:\n        +--------+-------+\n        |  NAME  | PARTS |\n        +--------+-------+\n        | part-3 | <nil> |\n        +--------+-------+\n    +--------+---------+\n  cruft-2 | <nil>     \n", string(marshaledBytes))
+}
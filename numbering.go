@@ -0,0 +1,23 @@
+package tableprinter
+
+// numberRows adds a leading column which numbers the rows in the order they are displayed (subtotals aren't counted):
+func (p *Printer) numberRows(table *Table) *Table {
+	var numberedTable = *table
+	numberedTable.headers = append([]string{rowNumberHeader}, table.headers...)
+	numberedTable.rows = nil
+
+	var rowNumber = p.rowNumbersFrom
+	for _, row := range table.rows {
+		numberedRow := make(tableRow)
+		for header, value := range row {
+			numberedRow.setField(header, value)
+		}
+		if !p.isSubtotalRow(row) {
+			numberedRow.setField(rowNumberHeader, p.cellFromValue(rowNumber))
+			rowNumber++
+		}
+		numberedTable.addRow(numberedRow)
+	}
+
+	return &numberedTable
+}
@@ -0,0 +1,65 @@
+package tableprinter_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+type numberingTestRow struct {
+	Name   string
+	Weight int
+}
+
+var numberingTestRows = []numberingTestRow{
+	{"cruft-1", 12},
+	{"cruft-2", 5},
+	{"cruft-3", 7},
+}
+
+func TestRowNumbers(t *testing.T) {
+	tablePrinter := tableprinter.New().WithRowNumbers(1)
+
+	// Rows are numbered from the start:
+	marshaledBytes, err := tablePrinter.Marshal(numberingTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  # |  NAME   | WEIGHT  \n+---+---------+--------+\n  1 | cruft-1 |     12  \n  2 | cruft-2 |      5  \n  3 | cruft-3 |      7  \n", string(marshaledBytes))
+
+	// Subtotals aren't counted:
+	marshaledBytes, err = tablePrinter.WithGroupBy("Name").WithSubtotals(map[string]tableprinter.Aggregate{"Weight": tableprinter.Sum}).Marshal(numberingTestRows[:2])
+	assert.NoError(t, err)
+	assert.Equal(t, "  # |   NAME   | WEIGHT  \n+---+----------+--------+\n  1 | cruft-1  |     12  \n    | subtotal |     12  \n  2 | cruft-2  |      5  \n    | subtotal |      5  \n", string(marshaledBytes))
+}
+
+func TestIndexColumn(t *testing.T) {
+	tablePrinter := tableprinter.New().WithIndexColumn(true)
+
+	// Slices show the index of each element (even when sorted):
+	marshaledBytes, err := tablePrinter.WithGroupBy("Weight").Marshal(numberingTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  WEIGHT | INDEX |  NAME    \n+--------+-------+---------+\n       5 |     1 | cruft-2  \n       7 |     2 | cruft-3  \n      12 |     0 | cruft-1  \n", string(marshaledBytes))
+
+	// Maps of structs show their keys (alongside row numbers):
+	marshaledBytes, err = tablePrinter.WithRowNumbers(0).Marshal(map[string]*numberingTestRow{
+		"second": &numberingTestRows[1],
+		"first":  &numberingTestRows[0],
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "  # | INDEX  |  NAME   | WEIGHT  \n+---+--------+---------+--------+\n  0 | first  | cruft-1 |     12  \n  1 | second | cruft-2 |      5  \n", string(marshaledBytes))
+
+	// Column expressions get an index too:
+	marshaledBytes, err = tablePrinter.WithColumns("Name").Marshal(numberingTestRows[:2])
+	assert.NoError(t, err)
+	assert.Equal(t, "  INDEX |  NAME    \n+-------+---------+\n      0 | cruft-1  \n      1 | cruft-2  \n", string(marshaledBytes))
+}
+
+func TestPagerRowNumbers(t *testing.T) {
+	terminal := &fakeTerminal{keyPresses: strings.NewReader(">ssq")}
+
+	// Row numbers follow the sorted rows, while the index stays with its row:
+	err := tableprinter.New().WithIndexColumn(true).WithRowNumbers(1).WithTerminal(terminal).Page(numberingTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  # | INDEX |  NAME   | WEIGHT  \r\n+---+-------+---------+--------+\r\n  1 |     2 | cruft-3 |      7  \r\n  2 |     1 | cruft-2 |      5  \r\n  3 |     0 | cruft-1 |     12  \r\n\x1b[7m column: Name | sorted by: Name (desc) |", terminal.lastScreen())
+}
@@ -0,0 +1,242 @@
+package tableprinter
+
+import "io"
+
+// Option configures a Printer (see New() and Printer.With()):
+type Option func(*Printer)
+
+// WithBorders causes the printer to add borders to tables:
+func WithBorders(borders bool) Option {
+	return func(p *Printer) {
+		p.borders = borders
+	}
+}
+
+// WithCaption adds a caption under tables (wrapped to the width of the table):
+func WithCaption(caption string) Option {
+	return func(p *Printer) {
+		p.caption = caption
+	}
+}
+
+// WithColour causes the printer to use ANSI colours (eg to highlight differences):
+func WithColour(colour bool) Option {
+	return func(p *Printer) {
+		p.colour = colour
+	}
+}
+
+// WithColumnConfig configures how a column is rendered (eg its alignment, a maximum width, or as a bar chart):
+func WithColumnConfig(header string, config ColumnConfig) Option {
+	return func(p *Printer) {
+		columnConfigs := map[string]ColumnConfig{header: config}
+		for existingHeader, existingConfig := range p.columnConfigs {
+			if existingHeader != header {
+				columnConfigs[existingHeader] = existingConfig
+			}
+		}
+		p.columnConfigs = columnConfigs
+	}
+}
+
+// WithColumns causes the printer to build each column from an expression (eg "Name=.metadata.name"):
+func WithColumns(columns ...string) Option {
+	columns = append([]string{}, columns...)
+	return func(p *Printer) {
+		p.columns = columns
+	}
+}
+
+// WithEastAsianWidth causes the printer to treat characters of ambiguous width (eg "·" and "±") as two columns wide, as
+// CJK terminals do (by default this follows the locale):
+func WithEastAsianWidth(eastAsianWidth bool) Option {
+	return func(p *Printer) {
+		p.eastAsianWidth = eastAsianWidth
+	}
+}
+
+// WithFlattenedStructs causes the printer to give the fields of nested structs their own columns, under a header spanning them (eg "Resources" over "CPU | Memory"):
+func WithFlattenedStructs(flattenStructs bool) Option {
+	return func(p *Printer) {
+		p.flattenStructs = flattenStructs
+	}
+}
+
+// WithFooter adds a footer to tables, with the results of aggregates (eg Sum, Count) applied to the columns they are keyed by:
+func WithFooter(aggregates map[string]Aggregate) Option {
+	footer := make(map[string]Aggregate)
+	for header, aggregate := range aggregates {
+		footer[header] = aggregate
+	}
+	return func(p *Printer) {
+		p.footer = footer
+	}
+}
+
+// WithGroupBy sorts rows into groups by the values in a column (which is moved to the front, and only shows each value once):
+func WithGroupBy(header string) Option {
+	return func(p *Printer) {
+		p.groupBy = header
+	}
+}
+
+// WithHeaderCase sets how headers (and header groups) are displayed (upper-cased by default, or preserved, in Title Case, or humanised from CamelCase and snake_case):
+func WithHeaderCase(headerCase HeaderCase) Option {
+	return func(p *Printer) {
+		p.headerCase = headerCase
+	}
+}
+
+// WithIndexColumn adds a leading "index" column, with the slice index (or map key) each row came from (which stays with the row when it is sorted or grouped):
+func WithIndexColumn(indexColumn bool) Option {
+	return func(p *Printer) {
+		p.indexColumn = indexColumn
+	}
+}
+
+// WithKeyColumns identifies rows by the values in these columns (so rows can be matched up between renders):
+func WithKeyColumns(keyColumns ...string) Option {
+	keyColumns = append([]string{}, keyColumns...)
+	return func(p *Printer) {
+		p.keyColumns = keyColumns
+	}
+}
+
+// WithLimit causes the printer to show at most this many rows (followed by a line saying which rows are being shown):
+func WithLimit(limit int) Option {
+	return func(p *Printer) {
+		p.limit = limit
+	}
+}
+
+// WithMergedCells causes the printer to merge runs of identical values in every column (showing each value once):
+func WithMergedCells(mergeAllCells bool) Option {
+	return func(p *Printer) {
+		p.mergeAllCells = mergeAllCells
+	}
+}
+
+// WithMergedColumns causes the printer to merge runs of identical values in these columns (a cell is only merged if any merged columns to its left were merged too):
+func WithMergedColumns(headers ...string) Option {
+	mergedColumns := append([]string{}, headers...)
+	return func(p *Printer) {
+		p.mergedColumns = mergedColumns
+	}
+}
+
+// WithNestedDetails causes nested tables (see WithNestedTables) to be shown in a block under their row (instead of inside the cell):
+func WithNestedDetails(nestedDetails bool) Option {
+	return func(p *Printer) {
+		p.nestedDetails = nestedDetails
+	}
+}
+
+// WithNestedTables causes the printer to render fields which are slices of structs as tables of their own, up to this many levels deep:
+func WithNestedTables(depth int) Option {
+	return func(p *Printer) {
+		p.nestedTables = depth
+	}
+}
+
+// WithOffset causes the printer to skip this many rows (followed by a line saying which rows are being shown):
+func WithOffset(offset int) Option {
+	return func(p *Printer) {
+		p.offset = offset
+	}
+}
+
+// WithOutput adds an output to the printer:
+func WithOutput(output io.Writer) Option {
+	return func(p *Printer) {
+		p.output = output
+	}
+}
+
+// WithPageSize sets the number of rows on each page of Printer.Paginate():
+func WithPageSize(pageSize int) Option {
+	return func(p *Printer) {
+		p.pageSize = pageSize
+	}
+}
+
+// WithPath causes the printer to select part of a value (eg ".items[].metadata") before printing it:
+func WithPath(path string) Option {
+	return func(p *Printer) {
+		p.path = path
+	}
+}
+
+// WithRowNumbers adds a leading "#" column, numbering rows in the order they are displayed (counting up from start):
+func WithRowNumbers(start int) Option {
+	return func(p *Printer) {
+		p.rowNumbers = true
+		p.rowNumbersFrom = start
+	}
+}
+
+// WithRowSeparators draws a line between rows (left open across merged cells, which carry on into the next row):
+func WithRowSeparators(rowSeparators bool) Option {
+	return func(p *Printer) {
+		p.rowSeparators = rowSeparators
+	}
+}
+
+// WithSortedHeaders causes the printer to alphabetically sort columns by their headers:
+func WithSortedHeaders(sortedHeaders bool) Option {
+	return func(p *Printer) {
+		p.sortedHeaders = sortedHeaders
+	}
+}
+
+// WithSubtotals adds a row after each group (see WithGroupBy), with the results of aggregates applied to the columns they are keyed by:
+func WithSubtotals(aggregates map[string]Aggregate) Option {
+	subtotals := make(map[string]Aggregate)
+	for header, aggregate := range aggregates {
+		subtotals[header] = aggregate
+	}
+	return func(p *Printer) {
+		p.subtotals = subtotals
+	}
+}
+
+// WithSummary adds a line under tables saying how many rows they have (before any limit or offset) and when they were generated:
+func WithSummary(summary bool) Option {
+	return func(p *Printer) {
+		p.summary = summary
+	}
+}
+
+// WithTerminal causes the interactive pager to use a specific terminal (instead of stdin / stdout):
+func WithTerminal(terminal Terminal) Option {
+	return func(p *Printer) {
+		p.terminal = terminal
+	}
+}
+
+// WithTitle adds a title above tables (centred within the width of the table):
+func WithTitle(title string) Option {
+	return func(p *Printer) {
+		p.title = title
+	}
+}
+
+// WithTranspose causes the printer to swap rows and columns, listing fields down the left with a column for each row (headed by the values of any key columns), and any footer as the last column:
+func WithTranspose(transpose bool) Option {
+	return func(p *Printer) {
+		p.transpose = transpose
+	}
+}
+
+// WithUnifiedDiff causes the printer to render differences as a unified text diff (when colour is off):
+func WithUnifiedDiff(unifiedDiff bool) Option {
+	return func(p *Printer) {
+		p.unifiedDiff = unifiedDiff
+	}
+}
+
+// WithZebra makes long tables easier to follow across, by shading the background of every other row when colour is enabled (or leaving a blank line every few rows when it isn't):
+func WithZebra(style ZebraStyle) Option {
+	return func(p *Printer) {
+		p.zebra = style
+	}
+}
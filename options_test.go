@@ -0,0 +1,71 @@
+package tableprinter_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+
+	// Options can be given to New():
+	tablePrinter := tableprinter.New(tableprinter.WithBorders(true), tableprinter.WithOutput(outputBuffer))
+	assert.NoError(t, tablePrinter.Print("cruft"))
+	assert.Equal(t, "+-------+\n| VALUE |\n+-------+\n| cruft |\n+-------+\n", outputBuffer.String())
+
+	// With() returns a modified copy, leaving the original alone:
+	borderlessPrinter := tablePrinter.With(tableprinter.WithBorders(false))
+	marshaledBytes, err := borderlessPrinter.Marshal("cruft")
+	assert.NoError(t, err)
+	assert.Equal(t, "  VALUE  \n+-------+\n  cruft  \n", string(marshaledBytes))
+
+	marshaledBytes, err = tablePrinter.Marshal("cruft")
+	assert.NoError(t, err)
+	assert.Equal(t, "+-------+\n| VALUE |\n+-------+\n| cruft |\n+-------+\n", string(marshaledBytes))
+
+	// So do the With*() methods:
+	assert.False(t, tablePrinter == tablePrinter.WithBorders(true))
+}
+
+func TestConcurrency(t *testing.T) {
+	var waitGroup sync.WaitGroup
+	sharedPrinter := tableprinter.New()
+
+	// Reconfigure printers while they're being used (run with -race):
+	for i := 0; i < 10; i++ {
+		waitGroup.Add(4)
+
+		go func(i int) {
+			defer waitGroup.Done()
+			tableprinter.SetSortedHeaders(i%2 == 0)
+			tableprinter.Configure(tableprinter.WithBorders(i%2 == 0), tableprinter.WithColour(false))
+		}(i)
+
+		go func() {
+			defer waitGroup.Done()
+			_, err := tableprinter.Marshal(complexStructure{Name: "cruft"})
+			assert.NoError(t, err)
+		}()
+
+		go func(i int) {
+			defer waitGroup.Done()
+			_, err := sharedPrinter.WithBorders(i%2 == 0).WithColumns("Name").Marshal(complexStructure{Name: "cruft"})
+			assert.NoError(t, err)
+		}(i)
+
+		go func() {
+			defer waitGroup.Done()
+			_, err := sharedPrinter.Marshal([]string{"this", "is", "quite", "crufty"})
+			assert.NoError(t, err)
+		}()
+	}
+
+	waitGroup.Wait()
+
+	// Put the default printer back the way it was:
+	tableprinter.Configure(tableprinter.WithBorders(false), tableprinter.WithSortedHeaders(true))
+}
@@ -0,0 +1,444 @@
+package tableprinter
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	// pagerScrollColumns is how far the pager scrolls sideways for each left / right keypress:
+	pagerScrollColumns = 8
+
+	// Fallback dimensions for terminals which don't report their size:
+	defaultTerminalHeight = 24
+	defaultTerminalWidth  = 80
+)
+
+// pager is an interactive full-screen viewer for a table:
+type pager struct {
+	currentMatch   int
+	hiddenHeaders  map[string]bool
+	lines          []string
+	headerLines    int
+	leftColumn     int
+	matches        []int
+	printer        *Printer
+	search         string
+	searching      bool
+	selectedHeader int
+	sortDescending bool
+	sortHeader     string
+	table          *Table
+	terminal       Terminal
+	topLine        int
+	height         int
+	width          int
+}
+
+// Page opens an interactive full-screen viewer on a table (or just prints it if there is no terminal):
+func (p *Printer) Page(value interface{}) error {
+
+	// Turn the value into a table:
+	table, err := p.tableFromValue(value)
+	if err != nil {
+		return err
+	}
+	if len(table.rows) == 0 {
+		return ErrNoData
+	}
+
+	// Use the configured terminal, or fall back to stdin / stdout:
+	terminal := p.terminal
+	if terminal == nil {
+		terminal = newTerminal()
+	}
+
+	// Without a terminal we can only print the table:
+	restore, err := terminal.MakeRaw()
+	if err != nil {
+		return p.Print(value)
+	}
+	defer restore()
+
+	// Use the alternate screen so the pager doesn't leave anything behind:
+	fmt.Fprint(terminal, ansiAlternateScreen+ansiHideCursor)
+	defer fmt.Fprint(terminal, ansiReset+ansiShowCursor+ansiMainScreen)
+
+	pager := &pager{
+		printer:       p,
+		hiddenHeaders: make(map[string]bool),
+		table:         table,
+		terminal:      terminal,
+	}
+
+	return pager.run()
+}
+
+// run draws the table and handles keypresses until the user quits:
+func (pg *pager) run() error {
+	reader := bufio.NewReader(pg.terminal)
+
+	if err := pg.render(); err != nil {
+		return err
+	}
+
+	for {
+		if err := pg.draw(); err != nil {
+			return err
+		}
+
+		keyPress, err := readKey(reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Search terms are typed in before anything else happens:
+		if pg.searching {
+			pg.handleSearchKey(keyPress)
+			continue
+		}
+
+		quit, err := pg.handleKey(keyPress)
+		if err != nil || quit {
+			return err
+		}
+	}
+}
+
+// handleKey acts on a keypress, returning true when it is time to quit:
+func (pg *pager) handleKey(keyPress keyPress) (bool, error) {
+	bodyHeight := pg.bodyHeight()
+
+	switch keyPress.key {
+	case keyInterrupt:
+		return true, nil
+	case keyUp:
+		pg.scrollTo(pg.topLine - 1)
+	case keyDown:
+		pg.scrollTo(pg.topLine + 1)
+	case keyPageUp:
+		pg.scrollTo(pg.topLine - bodyHeight)
+	case keyPageDown:
+		pg.scrollTo(pg.topLine + bodyHeight)
+	case keyHome:
+		pg.scrollTo(0)
+	case keyEnd:
+		pg.scrollTo(len(pg.lines))
+	case keyLeft:
+		pg.scrollAcross(pg.leftColumn - pagerScrollColumns)
+	case keyRight:
+		pg.scrollAcross(pg.leftColumn + pagerScrollColumns)
+	case keyTab:
+		pg.selectHeader(pg.selectedHeader + 1)
+	case keyRune:
+		return pg.handleCommand(keyPress.char)
+	}
+
+	return false, nil
+}
+
+// handleCommand acts on a single-character command:
+func (pg *pager) handleCommand(command rune) (bool, error) {
+	bodyHeight := pg.bodyHeight()
+
+	switch command {
+	case 'q', 'Q':
+		return true, nil
+	case 'k':
+		pg.scrollTo(pg.topLine - 1)
+	case 'j':
+		pg.scrollTo(pg.topLine + 1)
+	case 'b':
+		pg.scrollTo(pg.topLine - bodyHeight)
+	case ' ':
+		pg.scrollTo(pg.topLine + bodyHeight)
+	case 'g':
+		pg.scrollTo(0)
+	case 'G':
+		pg.scrollTo(len(pg.lines))
+	case 'h':
+		pg.scrollAcross(pg.leftColumn - pagerScrollColumns)
+	case 'l':
+		pg.scrollAcross(pg.leftColumn + pagerScrollColumns)
+	case '<':
+		pg.selectHeader(pg.selectedHeader - 1)
+	case '>':
+		pg.selectHeader(pg.selectedHeader + 1)
+	case '/':
+		pg.searching = true
+		pg.search = ""
+	case 'n':
+		pg.nextMatch(1)
+	case 'N':
+		pg.nextMatch(-1)
+
+	// Sort by the selected column (sorting by it again reverses the order):
+	case 's':
+		header := pg.visibleHeaders()[pg.selectedHeader]
+		pg.sortDescending = header == pg.sortHeader && !pg.sortDescending
+		pg.sortHeader = header
+		return false, pg.render()
+
+	// Hide the selected column (as long as it isn't the last one):
+	case 'x':
+		visibleHeaders := pg.visibleHeaders()
+		if len(visibleHeaders) > 1 {
+			pg.hiddenHeaders[visibleHeaders[pg.selectedHeader]] = true
+			pg.selectHeader(pg.selectedHeader)
+			return false, pg.render()
+		}
+
+	// Show all of the columns again:
+	case 'a':
+		pg.hiddenHeaders = make(map[string]bool)
+		return false, pg.render()
+	}
+
+	return false, nil
+}
+
+// handleSearchKey edits the search term:
+func (pg *pager) handleSearchKey(keyPress keyPress) {
+	switch keyPress.key {
+	case keyEnter:
+		pg.searching = false
+		pg.findMatches()
+		pg.firstMatch()
+	case keyEscape, keyInterrupt:
+		pg.searching = false
+		pg.search = ""
+		pg.findMatches()
+	case keyBackspace:
+		if searchRunes := []rune(pg.search); len(searchRunes) > 0 {
+			pg.search = string(searchRunes[:len(searchRunes)-1])
+		}
+	case keyRune:
+		pg.search += string(keyPress.char)
+	}
+}
+
+// visibleHeaders returns the headers which haven't been hidden:
+func (pg *pager) visibleHeaders() []string {
+	var visibleHeaders []string
+	for _, header := range pg.table.headers {
+		if !pg.hiddenHeaders[header] {
+			visibleHeaders = append(visibleHeaders, header)
+		}
+	}
+	return visibleHeaders
+}
+
+// selectHeader selects a column (wrapping around at either end):
+func (pg *pager) selectHeader(selectedHeader int) {
+	visibleHeaders := len(pg.visibleHeaders())
+	pg.selectedHeader = (selectedHeader%visibleHeaders + visibleHeaders) % visibleHeaders
+}
+
+// render sorts the rows, hides columns, and renders the table into lines:
+func (pg *pager) render() error {
+	var renderTable = &Table{
+		headerGroups: pg.table.headerGroups,
+		headerLabels: pg.table.headerLabels,
+		headers:      pg.visibleHeaders(),
+		rows:         append([]tableRow{}, pg.table.rows...),
+	}
+
+	// Sort the rows (by their original values):
+	if pg.sortHeader != "" {
+		sort.SliceStable(renderTable.rows, func(i, j int) bool {
+			if pg.sortDescending {
+				return lessCell(renderTable.rows[j][pg.sortHeader], renderTable.rows[i][pg.sortHeader])
+			}
+			return lessCell(renderTable.rows[i][pg.sortHeader], renderTable.rows[j][pg.sortHeader])
+		})
+	}
+
+	// Number the rows (in the order they are displayed):
+	if pg.printer.rowNumbers {
+		renderTable = pg.printer.numberRows(renderTable)
+	}
+	renderTable = pg.printer.wrapCells(renderTable)
+
+	tableBytes, err := renderTable.bytes(pg.printer)
+	if err != nil {
+		return err
+	}
+	pg.lines = strings.Split(strings.TrimSuffix(string(tableBytes), "\n"), "\n")
+
+	// The header (and the borders around it) stays at the top of the screen:
+	pg.headerLines = renderTable.headerLines(pg.printer.borders)
+
+	pg.findMatches()
+	pg.scrollTo(pg.topLine)
+	return nil
+}
+
+// draw writes a full screen of the table to the terminal:
+func (pg *pager) draw() error {
+	width, height, err := pg.terminal.Size()
+	if err != nil {
+		return err
+	}
+	if width <= 0 || height <= 0 {
+		width, height = defaultTerminalWidth, defaultTerminalHeight
+	}
+	pg.width, pg.height = width, height
+	pg.scrollTo(pg.topLine)
+	pg.scrollAcross(pg.leftColumn)
+
+	screen := bytes.NewBufferString(ansiClearScreen)
+
+	// Frozen header lines:
+	for _, line := range pg.lines[:pg.headerLines] {
+		screen.WriteString(pg.cropLine(line) + ansiClearLine + "\r\n")
+	}
+
+	// As many body lines as will fit:
+	bodyLines := pg.lines[pg.headerLines:]
+	for lineNumber := pg.topLine; lineNumber < pg.topLine+pg.bodyHeight(); lineNumber++ {
+		if lineNumber < len(bodyLines) {
+			screen.WriteString(pg.highlight(pg.cropLine(bodyLines[lineNumber])))
+		}
+		screen.WriteString(ansiClearLine + "\r\n")
+	}
+
+	// Status line (which doesn't scroll sideways):
+	status := []rune(pg.status())
+	if len(status) > pg.width {
+		status = status[:pg.width]
+	}
+	screen.WriteString(ansiReverse + string(status) + ansiClearLine + ansiReset)
+
+	_, err = screen.WriteTo(pg.terminal)
+	return err
+}
+
+// status describes where we are, and how to drive the pager:
+func (pg *pager) status() string {
+	if pg.searching {
+		return "/" + pg.search
+	}
+
+	visibleHeaders := pg.visibleHeaders()
+	status := fmt.Sprintf(" column: %s", visibleHeaders[pg.selectedHeader])
+	if pg.sortHeader != "" {
+		sortOrder := "asc"
+		if pg.sortDescending {
+			sortOrder = "desc"
+		}
+		status += fmt.Sprintf(" | sorted by: %s (%s)", pg.sortHeader, sortOrder)
+	}
+	if hiddenHeaders := len(pg.table.headers) - len(visibleHeaders); hiddenHeaders > 0 {
+		status += fmt.Sprintf(" | hidden: %d", hiddenHeaders)
+	}
+	if pg.search != "" {
+		status += fmt.Sprintf(" | /%s: %d matches", pg.search, len(pg.matches))
+	}
+
+	return status + " | </>:column s:sort x:hide a:show /:search q:quit"
+}
+
+// bodyHeight is the number of table lines which fit between the header and the status line:
+func (pg *pager) bodyHeight() int {
+	if bodyHeight := pg.height - pg.headerLines - 1; bodyHeight > 0 {
+		return bodyHeight
+	}
+	return 1
+}
+
+// scrollTo moves the first visible body line (without scrolling past the end of the table):
+func (pg *pager) scrollTo(topLine int) {
+	if maxTopLine := len(pg.lines) - pg.headerLines - pg.bodyHeight(); topLine > maxTopLine {
+		topLine = maxTopLine
+	}
+	if topLine < 0 {
+		topLine = 0
+	}
+	pg.topLine = topLine
+}
+
+// scrollAcross moves the first visible column of characters:
+func (pg *pager) scrollAcross(leftColumn int) {
+	var maxLeftColumn int
+	for _, line := range pg.lines {
+		if lineWidth := displayWidth(line, pg.printer.eastAsianWidth) - pg.width; lineWidth > maxLeftColumn {
+			maxLeftColumn = lineWidth
+		}
+	}
+	if leftColumn > maxLeftColumn {
+		leftColumn = maxLeftColumn
+	}
+	if leftColumn < 0 {
+		leftColumn = 0
+	}
+	pg.leftColumn = leftColumn
+}
+
+// cropLine cuts a line down to the visible part of the screen:
+func (pg *pager) cropLine(line string) string {
+	return cropDisplay(line, pg.leftColumn, pg.width, pg.printer.eastAsianWidth)
+}
+
+// findMatches finds the body lines which contain the search term:
+func (pg *pager) findMatches() {
+	pg.matches = nil
+	if pg.search == "" {
+		return
+	}
+	search := strings.ToLower(pg.search)
+	for lineNumber, line := range pg.lines[pg.headerLines:] {
+		if strings.Contains(strings.ToLower(line), search) {
+			pg.matches = append(pg.matches, lineNumber)
+		}
+	}
+}
+
+// firstMatch scrolls to the first line matching the search term (starting from the top of the screen):
+func (pg *pager) firstMatch() {
+	if len(pg.matches) == 0 {
+		return
+	}
+	pg.currentMatch = sort.SearchInts(pg.matches, pg.topLine) % len(pg.matches)
+	pg.scrollTo(pg.matches[pg.currentMatch])
+}
+
+// nextMatch scrolls to the next (or previous) line matching the search term (wrapping around at either end):
+func (pg *pager) nextMatch(direction int) {
+	if len(pg.matches) == 0 {
+		return
+	}
+	pg.currentMatch = ((pg.currentMatch+direction)%len(pg.matches) + len(pg.matches)) % len(pg.matches)
+	pg.scrollTo(pg.matches[pg.currentMatch])
+}
+
+// highlight shows occurrences of the search term in reverse video:
+func (pg *pager) highlight(line string) string {
+	if pg.search == "" || pg.searching {
+		return line
+	}
+
+	// Only highlight if lower-casing the line hasn't moved anything around:
+	lowerLine, search := strings.ToLower(line), strings.ToLower(pg.search)
+	if len(lowerLine) != len(line) {
+		return line
+	}
+
+	var highlighted strings.Builder
+	for {
+		matchIndex := strings.Index(lowerLine, search)
+		if matchIndex < 0 {
+			highlighted.WriteString(line)
+			return highlighted.String()
+		}
+		matchEnd := matchIndex + len(search)
+		highlighted.WriteString(line[:matchIndex] + ansiReverse + line[matchIndex:matchEnd] + ansiReverseOff)
+		line, lowerLine = line[matchEnd:], lowerLine[matchEnd:]
+	}
+}
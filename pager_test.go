@@ -0,0 +1,148 @@
+package tableprinter_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTerminal replays keypresses and records everything drawn on it:
+type fakeTerminal struct {
+	bytes.Buffer
+	keyPresses *strings.Reader
+	raw        bool
+	restored   bool
+	width      int
+}
+
+func (t *fakeTerminal) Read(p []byte) (int, error) {
+	return t.keyPresses.Read(p)
+}
+
+func (t *fakeTerminal) MakeRaw() (func() error, error) {
+	t.raw = true
+	return func() error {
+		t.restored = true
+		return nil
+	}, nil
+}
+
+func (t *fakeTerminal) Size() (int, int, error) {
+	if t.width > 0 {
+		return t.width, 6, nil
+	}
+	return 40, 6, nil
+}
+
+// lastScreen returns the final screen drawn on the terminal (without the clear-screen and clear-line sequences):
+func (t *fakeTerminal) lastScreen() string {
+	screens := strings.Split(t.String(), "\x1b[H\x1b[2J")
+	lastScreen := screens[len(screens)-1]
+	lastScreen = strings.Split(lastScreen, "\x1b[0m")[0]
+	return strings.Replace(lastScreen, "\x1b[K", "", -1)
+}
+
+type pagerTestCase struct {
+	expectedScreen string
+	keyPresses     string
+	width          int
+}
+
+var (
+	pagerTestRows []struct {
+		Name   string
+		Weight int
+		Crufty bool
+	}
+
+	pagerTests = map[string]pagerTestCase{
+		"Quit straight away": {
+			keyPresses:     "q",
+			expectedScreen: "  CRUFTY |   NAME   | WEIGHT  \r\n+--------+----------+--------+\r\n   true  | cruft-0  |      0  \r\n  false  | cruft-1  |     10  \r\n   true  | cruft-2  |     20  \r\n\x1b[7m column: Crufty | </>:column s:sort x:hi",
+		},
+		"Scroll down": {
+			keyPresses:     "jj\x1b[Bq",
+			expectedScreen: "  CRUFTY |   NAME   | WEIGHT  \r\n+--------+----------+--------+\r\n  false  | cruft-3  |     30  \r\n   true  | cruft-4  |     40  \r\n  false  | cruft-5  |     50  \r\n\x1b[7m column: Crufty | </>:column s:sort x:hi",
+		},
+		"Scroll past the end": {
+			keyPresses:     "G\x1b[Bq",
+			expectedScreen: "  CRUFTY |   NAME   | WEIGHT  \r\n+--------+----------+--------+\r\n  false  | cruft-9  |     90  \r\n   true  | cruft-10 |    100  \r\n  false  | cruft-11 |    110  \r\n\x1b[7m column: Crufty | </>:column s:sort x:hi",
+		},
+		"Scroll across": {
+			width:          20,
+			keyPresses:     "l\x1b[Cq",
+			expectedScreen: "   NAME   | WEIGHT  \r\n----------+--------+\r\n cruft-0  |      0  \r\n cruft-1  |     10  \r\n cruft-2  |     20  \r\n\x1b[7m column: Crufty | </",
+		},
+		"Sort descending by weight": {
+			keyPresses:     ">>ssq",
+			expectedScreen: "  CRUFTY |   NAME   | WEIGHT  \r\n+--------+----------+--------+\r\n  false  | cruft-11 |    110  \r\n   true  | cruft-10 |    100  \r\n  false  | cruft-9  |     90  \r\n\x1b[7m column: Weight | sorted by: Weight (des",
+		},
+		"Hide a column": {
+			keyPresses:     "\txq",
+			expectedScreen: "  CRUFTY | WEIGHT  \r\n+--------+--------+\r\n   true  |      0  \r\n  false  |     10  \r\n   true  |     20  \r\n\x1b[7m column: Weight | hidden: 1 | </>:column",
+		},
+		"Show all columns": {
+			keyPresses:     "xaq",
+			expectedScreen: "  CRUFTY |   NAME   | WEIGHT  \r\n+--------+----------+--------+\r\n   true  | cruft-0  |      0  \r\n  false  | cruft-1  |     10  \r\n   true  | cruft-2  |     20  \r\n\x1b[7m column: Crufty | </>:column s:sort x:hi",
+		},
+		"Search": {
+			keyPresses:     "/CRUFT-1\x7f7\rq",
+			expectedScreen: "  CRUFTY |   NAME   | WEIGHT  \r\n+--------+----------+--------+\r\n  false  | \x1b[7mcruft-7\x1b[27m  |     70  \r\n   true  | cruft-8  |     80  \r\n  false  | cruft-9  |     90  \r\n\x1b[7m column: Crufty | /CRUFT-7: 1 matches | ",
+		},
+		"Next search match": {
+			keyPresses:     "/cruft-1\rnq",
+			expectedScreen: "  CRUFTY |   NAME   | WEIGHT  \r\n+--------+----------+--------+\r\n  false  | cruft-9  |     90  \r\n   true  | \x1b[7mcruft-1\x1b[27m0 |    100  \r\n  false  | \x1b[7mcruft-1\x1b[27m1 |    110  \r\n\x1b[7m column: Crufty | /cruft-1: 3 matches | ",
+		},
+		"Typing a search": {
+			keyPresses:     "/cruft",
+			expectedScreen: "  CRUFTY |   NAME   | WEIGHT  \r\n+--------+----------+--------+\r\n   true  | cruft-0  |      0  \r\n  false  | cruft-1  |     10  \r\n   true  | cruft-2  |     20  \r\n\x1b[7m/cruft",
+		},
+		"Cancel a search": {
+			keyPresses:     "/cruft\x1bq",
+			expectedScreen: "  CRUFTY |   NAME   | WEIGHT  \r\n+--------+----------+--------+\r\n   true  | cruft-0  |      0  \r\n  false  | cruft-1  |     10  \r\n   true  | cruft-2  |     20  \r\n\x1b[7m column: Crufty | </>:column s:sort x:hi",
+		},
+	}
+)
+
+func init() {
+	for i := 0; i < 12; i++ {
+		pagerTestRows = append(pagerTestRows, struct {
+			Name   string
+			Weight int
+			Crufty bool
+		}{fmt.Sprintf("cruft-%d", i), i * 10, i%2 == 0})
+	}
+}
+
+func TestPager(t *testing.T) {
+	for name, tc := range pagerTests {
+
+		// Run the test with its own name:
+		t.Run(name, func(t *testing.T) {
+			terminal := &fakeTerminal{keyPresses: strings.NewReader(tc.keyPresses), width: tc.width}
+
+			// Page through the rows:
+			err := tableprinter.New().WithTerminal(terminal).Page(pagerTestRows)
+			assert.NoError(t, err)
+
+			// Make sure the terminal was put into raw mode (and back again):
+			assert.True(t, terminal.raw)
+			assert.True(t, terminal.restored)
+
+			// Compare the final screen:
+			assert.Equal(t, tc.expectedScreen, terminal.lastScreen())
+		})
+	}
+}
+
+func TestPagerWithBorders(t *testing.T) {
+	terminal := &fakeTerminal{keyPresses: strings.NewReader("G")}
+
+	err := tableprinter.New().WithBorders(true).WithTerminal(terminal).Page(pagerTestRows[:3])
+	assert.NoError(t, err)
+	assert.Equal(t, "+--------+---------+--------+\r\n| CRUFTY |  NAME   | WEIGHT |\r\n+--------+---------+--------+\r\n|  true  | cruft-2 |     20 |\r\n+--------+---------+--------+\r\n\x1b[7m column: Crufty | </>:column s:sort x:hi", terminal.lastScreen())
+}
@@ -0,0 +1,323 @@
+package tableprinter
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// columnExpressionSeparator separates a column header from its path (eg "Name=.metadata.name"):
+	columnExpressionSeparator = "="
+	pathIndexClose            = "]"
+	pathIndexOpen             = "["
+	pathSeparator             = "."
+)
+
+// pathSegment is one step along a path (a field or key name, optionally followed by an index or an iterator):
+type pathSegment struct {
+	name    string
+	index   int
+	indexed bool
+	iterate bool
+}
+
+// column is a header paired with the path to its value (relative to each row):
+type column struct {
+	header string
+	path   []pathSegment
+}
+
+// parsePath turns a path expression (eg ".items[].metadata" or "Items") into segments:
+func parsePath(path string) ([]pathSegment, error) {
+	var segments []pathSegment
+
+	// The leading separator is optional, and an empty path selects the whole value:
+	path = strings.TrimPrefix(strings.TrimSpace(path), pathSeparator)
+	if path == "" {
+		return nil, nil
+	}
+
+	for _, part := range strings.Split(path, pathSeparator) {
+		segment := pathSegment{name: part}
+
+		// Look for an index ("[3]") or an iterator ("[]"):
+		if openIndex := strings.Index(part, pathIndexOpen); openIndex >= 0 {
+			if !strings.HasSuffix(part, pathIndexClose) {
+				return nil, ErrInvalidPath
+			}
+			segment.name = part[:openIndex]
+			indexValue := part[openIndex+1 : len(part)-1]
+
+			// An empty index means "every element":
+			if indexValue == "" {
+				segment.iterate = true
+			} else {
+				index, err := strconv.Atoi(indexValue)
+				if err != nil || index < 0 {
+					return nil, ErrInvalidPath
+				}
+				segment.index = index
+				segment.indexed = true
+			}
+		}
+
+		// Every segment needs a name, an index or an iterator:
+		if segment.name == "" && !segment.iterate && !segment.indexed {
+			return nil, ErrInvalidPath
+		}
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
+
+// parseColumns turns column expressions (eg "Name=.metadata.name" or just "Name") into columns:
+func parseColumns(expressions []string) ([]column, error) {
+	var columns []column
+
+	for _, expression := range expressions {
+		header, path := expression, expression
+
+		// Expressions without a separator use the same string for the header and the path:
+		if separatorIndex := strings.Index(expression, columnExpressionSeparator); separatorIndex >= 0 {
+			header = strings.TrimSpace(expression[:separatorIndex])
+			path = expression[separatorIndex+1:]
+		}
+		if header == "" {
+			return nil, ErrInvalidPath
+		}
+
+		segments, err := parsePath(path)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, column{header: header, path: segments})
+	}
+
+	return columns, nil
+}
+
+// selectPath follows a path through a value, returning a slice if the path iterated over anything:
+func selectPath(value interface{}, segments []pathSegment) (interface{}, error) {
+	var iterated bool
+	values := []reflect.Value{reflect.ValueOf(value)}
+
+	for _, segment := range segments {
+		var selectedValues []reflect.Value
+
+		for _, selectedValue := range values {
+
+			// Look up the named field or key:
+			if segment.name != "" {
+				fieldValue, ok := lookupField(selectedValue, segment.name)
+				if !ok {
+					return nil, ErrPathNotFound
+				}
+				selectedValue = fieldValue
+			}
+
+			switch {
+
+			// Iterators expand into every element:
+			case segment.iterate:
+				elements, ok := pathElements(selectedValue)
+				if !ok {
+					return nil, ErrPathNotFound
+				}
+				selectedValues = append(selectedValues, elements...)
+				iterated = true
+
+			// Indexes pick a single element:
+			case segment.indexed:
+				elements, ok := pathElements(selectedValue)
+				if !ok || segment.index >= len(elements) {
+					return nil, ErrPathNotFound
+				}
+				selectedValues = append(selectedValues, elements[segment.index])
+
+			default:
+				selectedValues = append(selectedValues, selectedValue)
+			}
+		}
+
+		values = selectedValues
+	}
+
+	// Anything which was iterated over comes back as a slice:
+	if iterated {
+		selectedSlice := make([]interface{}, 0, len(values))
+		for _, selectedValue := range values {
+			selectedSlice = append(selectedSlice, pathInterface(selectedValue))
+		}
+		return selectedSlice, nil
+	}
+
+	return pathInterface(values[0]), nil
+}
+
+// indirect follows pointers and interfaces until it finds a concrete value:
+func indirect(value reflect.Value) reflect.Value {
+	for value.IsValid() && (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// lookupField finds a struct field or map key by name (falling back to case-insensitive and JSON tag matches):
+func lookupField(value reflect.Value, name string) (reflect.Value, bool) {
+	value = indirect(value)
+	if !value.IsValid() {
+		return reflect.Value{}, false
+	}
+
+	switch value.Kind() {
+
+	case reflect.Struct:
+		if field, ok := value.Type().FieldByName(name); ok && field.PkgPath == "" {
+			return value.FieldByIndex(field.Index), true
+		}
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+			if strings.EqualFold(field.Name, name) || (jsonName != "" && jsonName == name) {
+				return value.Field(i), true
+			}
+		}
+
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}, false
+		}
+		key := reflect.ValueOf(name).Convert(value.Type().Key())
+		if mapValue := value.MapIndex(key); mapValue.IsValid() {
+			return mapValue, true
+		}
+		for _, key := range value.MapKeys() {
+			if strings.EqualFold(key.String(), name) {
+				return value.MapIndex(key), true
+			}
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// pathElements returns the elements of a slice, array or map (maps are ordered by key):
+func pathElements(value reflect.Value) ([]reflect.Value, bool) {
+	var elements []reflect.Value
+	value = indirect(value)
+	if !value.IsValid() {
+		return nil, false
+	}
+
+	switch value.Kind() {
+
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			elements = append(elements, value.Index(i))
+		}
+
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return formatKey(keys[i]) < formatKey(keys[j])
+		})
+		for _, key := range keys {
+			elements = append(elements, value.MapIndex(key))
+		}
+
+	default:
+		return nil, false
+	}
+
+	return elements, true
+}
+
+// formatKey renders a map key so that keys can be sorted:
+func formatKey(key reflect.Value) string {
+	if key.Kind() == reflect.String {
+		return key.String()
+	}
+	return fmt.Sprint(key.Interface())
+}
+
+// pathInterface returns the interface of a selected value (or nil if there isn't one):
+func pathInterface(value reflect.Value) interface{} {
+	if !value.IsValid() || !value.CanInterface() {
+		return nil
+	}
+	return value.Interface()
+}
+
+// selectValue applies the configured path to a value:
+func (p *Printer) selectValue(value interface{}) (interface{}, error) {
+	segments, err := parsePath(p.path)
+	if err != nil {
+		return nil, err
+	}
+	return selectPath(value, segments)
+}
+
+// tableFromColumns turns a value (or each element of a slice) into rows using column expressions. Windowed tables only
+// evaluate the expressions for the elements in the window:
+func (p *Printer) tableFromColumns(value interface{}, windowed bool) (*Table, error) {
+	var table = new(Table)
+
+	columns, err := parseColumns(p.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	// Slices become one row per element, anything else becomes a single row:
+	rowValues, ok := pathElements(reflect.ValueOf(value))
+	isSlice := ok && indirect(reflect.ValueOf(value)).Kind() != reflect.Map
+	if !isSlice {
+		rowValues = []reflect.Value{reflect.ValueOf(value)}
+	}
+
+	// Only keep the elements in the window:
+	var firstRow int
+	if windowed && isSlice && p.isWindowed() {
+		start, end := p.window(len(rowValues))
+		table.firstRow, table.totalRows = start, len(rowValues)
+		firstRow, rowValues = start, rowValues[start:end]
+	}
+
+	// Keep track of where each row came from (slice elements only):
+	if p.indexColumn && isSlice {
+		table.addHeader(indexFieldName)
+	}
+
+	for _, column := range columns {
+		table.addHeader(column.header)
+	}
+
+	for rowIndex, rowValue := range rowValues {
+		var row = make(tableRow)
+		if table.hasHeader(indexFieldName) {
+			row.setField(indexFieldName, p.cellFromValue(firstRow+rowIndex))
+		}
+
+		for _, column := range columns {
+			columnValue, err := selectPath(pathInterface(rowValue), column.path)
+			if err != nil || columnValue == nil {
+				row.setField(column.header, textCell(nilFieldValue))
+				continue
+			}
+			row.setField(column.header, p.cellFromValue(columnValue))
+		}
+
+		table.addRow(row)
+	}
+
+	return table, nil
+}
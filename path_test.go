@@ -0,0 +1,123 @@
+package tableprinter_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+type pathTestCase struct {
+	columns        []string
+	expectedError  error
+	expectedOutput string
+	path           string
+}
+
+var (
+	pathTestDocument = `{
+		"kind": "CruftList",
+		"items": [
+			{"metadata": {"name": "cruft-1", "namespace": "default"}, "spec": {"replicas": 3}, "status": {"owner": null, "phase": "Running"}},
+			{"metadata": {"name": "cruft-2", "namespace": "prawns"}, "spec": {"replicas": 1}, "status": {"owner": "prawn", "phase": "Pending"}}
+		]
+	}`
+
+	pathTests = map[string]pathTestCase{
+		"Iterate over a path": {
+			path:           ".items[].metadata",
+			expectedOutput: "   NAME   | NAMESPACE  \n+---------+-----------+\n  cruft-1 | default    \n  cruft-2 | prawns     \n",
+		},
+		"Index into a path": {
+			path:           ".items[1].metadata.name",
+			expectedOutput: "   VALUE   \n+---------+\n  cruft-2  \n",
+		},
+		"Path without a leading separator": {
+			path:           "Kind",
+			expectedOutput: "    VALUE    \n+-----------+\n  CruftList  \n",
+		},
+		"Column expressions": {
+			path:           "items",
+			columns:        []string{"Name=.metadata.name", "Replicas=.spec.replicas", "Missing=.spec.cruft"},
+			expectedOutput: "   NAME   | REPLICAS | MISSING  \n+---------+----------+---------+\n  cruft-1 |        3 | <nil>    \n  cruft-2 |        1 | <nil>    \n",
+		},
+		"Column expressions on a single value": {
+			columns:        []string{"kind", "Count=.items[].spec.replicas"},
+			expectedOutput: "    KIND    | COUNT  \n+-----------+-------+\n  CruftList | [3 1]  \n",
+		},
+		"Null values": {
+			path:           ".items[].status",
+			expectedOutput: "  OWNER |  PHASE   \n+-------+---------+\n  <nil> | Running  \n  prawn | Pending  \n",
+		},
+		"Missing path": {
+			path:          ".items[].cruft",
+			expectedError: tableprinter.ErrPathNotFound,
+		},
+		"Index out of range": {
+			path:          ".items[2]",
+			expectedError: tableprinter.ErrPathNotFound,
+		},
+		"Invalid path": {
+			path:          ".items[cruft]",
+			expectedError: tableprinter.ErrInvalidPath,
+		},
+		"Invalid column": {
+			columns:       []string{"=.kind"},
+			expectedError: tableprinter.ErrInvalidPath,
+		},
+	}
+)
+
+func TestPath(t *testing.T) {
+	var document interface{}
+
+	// Decode the test document in the same way a CLI would:
+	err := json.Unmarshal([]byte(pathTestDocument), &document)
+	assert.NoError(t, err)
+
+	for name, tc := range pathTests {
+
+		// Run the test with its own name:
+		t.Run(name, func(t *testing.T) {
+			outputBuffer := bytes.NewBufferString("")
+			tablePrinter := tableprinter.New().WithOutput(outputBuffer).WithPath(tc.path).WithColumns(tc.columns...)
+
+			// Print the value:
+			err := tablePrinter.Print(document)
+			if tc.expectedError != nil {
+				assert.Equal(t, tc.expectedError, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			// Compare the output:
+			assert.Equal(t, tc.expectedOutput, outputBuffer.String())
+		})
+	}
+}
+
+func TestPathWithStructs(t *testing.T) {
+	type metadata struct {
+		Name string `json:"name"`
+	}
+
+	type cruft struct {
+		Metadata *metadata
+		Weight   int
+	}
+
+	crufts := struct {
+		Items []cruft
+	}{
+		Items: []cruft{
+			{Metadata: &metadata{Name: "prawn"}, Weight: 5},
+			{Weight: 7},
+		},
+	}
+
+	marshaledBytes, err := tableprinter.New().WithPath("Items").WithColumns("Name=.metadata.name", "Weight").Marshal(crufts)
+	assert.NoError(t, err)
+	assert.Equal(t, "  NAME  | WEIGHT  \n+-------+--------+\n  prawn |      5  \n  <nil> |      7  \n", string(marshaledBytes))
+}
@@ -0,0 +1,124 @@
+package tableprinter
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// totalLabel heads the column (and labels the row) of pivot table totals:
+	totalLabel = "Total"
+)
+
+// Pivot turns a slice of records into a cross-tab, with a row for each distinct value of rowKey and a column for each
+// distinct value of columnKey. Cells hold the aggregate of valueField for the records they match. A column of totals is
+// added at the end, and a row of them goes in the footer:
+func (p *Printer) Pivot(value interface{}, rowKey, columnKey, valueField string, aggregate Aggregate) (*Table, error) {
+
+	// Reflect the records into a table first (all of them, since limits and offsets apply to the pivot table):
+	recordTable, err := p.recordTable(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(recordTable.rows) == 0 {
+		return nil, ErrNoData
+	}
+	for _, header := range []string{rowKey, columnKey, valueField} {
+		if !recordTable.hasHeader(header) {
+			return nil, ErrNoSuchCell
+		}
+	}
+
+	// Find the distinct row and column keys (in order):
+	rowKeys := distinctCells(recordTable.rows, rowKey)
+	columnKeys := distinctCells(recordTable.rows, columnKey)
+
+	// Collect the values for each cell (and the totals):
+	var cellValues = make(map[string]map[string][]interface{})
+	var rowTotalValues = make(map[string][]interface{})
+	var columnTotalValues = make(map[string][]interface{})
+	var totalValues []interface{}
+	for _, row := range recordTable.rows {
+		rowText, columnText, cellValue := row[rowKey].text, row[columnKey].text, row[valueField].value
+		if cellValues[rowText] == nil {
+			cellValues[rowText] = make(map[string][]interface{})
+		}
+		cellValues[rowText][columnText] = append(cellValues[rowText][columnText], cellValue)
+		rowTotalValues[rowText] = append(rowTotalValues[rowText], cellValue)
+		columnTotalValues[columnText] = append(columnTotalValues[columnText], cellValue)
+		totalValues = append(totalValues, cellValue)
+	}
+
+	// The row key comes first, then a column for each column key, then the totals. Column keys which clash with the
+	// other headers (eg a "Total" column key) are renamed:
+	var pivotTable = &Table{headers: []string{rowKey}}
+	totalHeader := pivotTable.uniqueHeader(totalLabel)
+	pivotTable.addHeader(totalHeader)
+	var columnHeaders = make(map[string]string)
+	for _, columnKeyCell := range columnKeys {
+		columnHeaders[columnKeyCell.text] = pivotTable.uniqueHeader(columnKeyCell.text)
+		pivotTable.addHeader(columnHeaders[columnKeyCell.text])
+	}
+	pivotTable.headers = append(append([]string{rowKey}, pivotTable.headers[2:]...), totalHeader)
+
+	// Add a row for each row key:
+	for _, rowKeyCell := range rowKeys {
+		pivotRow := tableRow{rowKey: rowKeyCell}
+		for _, columnKeyCell := range columnKeys {
+			if values, ok := cellValues[rowKeyCell.text][columnKeyCell.text]; ok {
+				pivotRow.setField(columnHeaders[columnKeyCell.text], p.aggregateCell(aggregate, values))
+			}
+		}
+		pivotRow.setField(totalHeader, p.aggregateCell(aggregate, rowTotalValues[rowKeyCell.text]))
+		pivotTable.addRow(pivotRow)
+	}
+
+	// Then a footer of totals (so they stay at the bottom, whichever rows are shown):
+	pivotTable.footer = tableRow{rowKey: textCell(totalLabel)}
+	for _, columnKeyCell := range columnKeys {
+		pivotTable.footer.setField(columnHeaders[columnKeyCell.text], p.aggregateCell(aggregate, columnTotalValues[columnKeyCell.text]))
+	}
+	pivotTable.footer.setField(totalHeader, p.aggregateCell(aggregate, totalValues))
+
+	return pivotTable, nil
+}
+
+// recordTable turns the selected part of a value into a table of records (without applying any limit or offset):
+func (p *Printer) recordTable(value interface{}) (*Table, error) {
+	value, err := p.selectValue(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(p.columns) > 0 {
+		return p.tableFromColumns(value, false)
+	}
+	return p.makeTable(value)
+}
+
+// uniqueHeader returns a header which the table doesn't have yet (adding a number to it if it does, eg "Total (2)"):
+func (t *Table) uniqueHeader(header string) string {
+	uniqueHeader := header
+	for i := 2; t.hasHeader(uniqueHeader); i++ {
+		uniqueHeader = fmt.Sprintf("%s (%d)", header, i)
+	}
+	return uniqueHeader
+}
+
+// distinctCells returns the different values in a column (in order):
+func distinctCells(rows []tableRow, header string) []cell {
+	var distinct []cell
+	var seen = make(map[string]bool)
+
+	for _, row := range rows {
+		if !seen[row[header].text] {
+			seen[row[header].text] = true
+			distinct = append(distinct, row[header])
+		}
+	}
+
+	sort.SliceStable(distinct, func(i, j int) bool {
+		return lessCell(distinct[i], distinct[j])
+	})
+
+	return distinct
+}
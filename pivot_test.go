@@ -0,0 +1,66 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+var pivotTestRows = []struct {
+	Service string
+	Month   int
+	Cost    float64
+}{
+	{"storage", 2, 1.5},
+	{"compute", 1, 10},
+	{"storage", 1, 2},
+	{"compute", 2, 12.25},
+	{"network", 2, 0.5},
+	{"compute", 1, 3},
+}
+
+func TestPivot(t *testing.T) {
+	tablePrinter := tableprinter.New()
+
+	// Months become columns, and missing combinations are left empty:
+	table, err := tableprinter.Pivot(pivotTestRows, "Service", "Month", "Cost", tableprinter.Sum)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Service", "1", "2", "Total"}, table.Headers())
+	renderedBytes, err := tablePrinter.Render(table)
+	assert.NoError(t, err)
+	assert.Equal(t, "  SERVICE | 1  |   2   | TOTAL  \n+---------+----+-------+-------+\n  compute | 13 | 12.25 | 25.25  \n  network |    |   0.5 |   0.5  \n  storage |  2 |   1.5 |   3.5  \n+---------+----+-------+-------+\n    Total | 15 | 14.25 | 29.25  \n+---------+----+-------+-------+\n", string(renderedBytes))
+
+	// Any aggregate can be used:
+	table, err = tablePrinter.Pivot(pivotTestRows, "Month", "Service", "Cost", tableprinter.Count)
+	assert.NoError(t, err)
+	renderedBytes, err = tablePrinter.Render(table)
+	assert.NoError(t, err)
+	assert.Equal(t, "  MONTH | COMPUTE | NETWORK | STORAGE | TOTAL  \n+-------+---------+---------+---------+-------+\n      1 |       2 |         |       1 |     3  \n      2 |       1 |       1 |       1 |     3  \n+-------+---------+---------+---------+-------+\n  Total |       3 |       1 |       2 |     6  \n+-------+---------+---------+---------+-------+\n", string(renderedBytes))
+
+	// Fields which don't exist:
+	_, err = tablePrinter.Pivot(pivotTestRows, "Service", "Year", "Cost", tableprinter.Sum)
+	assert.Equal(t, tableprinter.ErrNoSuchCell, err)
+
+	// Column keys which clash with the other headers are renamed:
+	clashingRows := []struct{ Region, Plan string }{{"us", "Total"}, {"us", "Region"}, {"eu", "Total"}}
+	table, err = tablePrinter.Pivot(clashingRows, "Region", "Plan", "Plan", tableprinter.Count)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Region", "Region (2)", "Total (2)", "Total"}, table.Headers())
+	cellText, _ := table.Cell(1, "Total (2)")
+	assert.Equal(t, "1", cellText)
+	cellText, _ = table.Cell(1, "Total")
+	assert.Equal(t, "2", cellText)
+
+	// Limits and offsets apply to the pivot table, not the records (and the totals stay in the footer):
+	table, err = tablePrinter.WithLimit(1).Pivot(pivotTestRows, "Service", "Month", "Cost", tableprinter.Count)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, table.NumRows())
+	renderedBytes, err = tablePrinter.WithLimit(1).WithOffset(2).Render(table)
+	assert.NoError(t, err)
+	assert.Equal(t, "  SERVICE | 1 | 2 | TOTAL  \n+---------+---+---+-------+\n  storage | 1 | 1 |     2  \n+---------+---+---+-------+\n    Total | 3 | 3 |     6  \n+---------+---+---+-------+\nshowing 3–3 of 3 rows\n", string(renderedBytes))
+
+	// There has to be something to pivot:
+	_, err = tablePrinter.Pivot(pivotTestRows[:0], "Service", "Month", "Cost", tableprinter.Sum)
+	assert.Equal(t, tableprinter.ErrNoData, err)
+}
@@ -4,20 +4,52 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/mattn/go-runewidth"
 )
 
-// Printer takes care of marshaling interfaces to text tables:
+// Printer takes care of marshaling interfaces to text tables (its configuration never changes, so it is safe for concurrent use):
 type Printer struct {
-	borders       bool
-	output        io.Writer
-	sortedHeaders bool
-	spewConfig    *spew.ConfigState
+	borders        bool
+	caption        string
+	colour         bool
+	columnConfigs  map[string]ColumnConfig
+	columns        []string
+	eastAsianWidth bool
+	flattenStructs bool
+	footer         map[string]Aggregate
+	groupBy        string
+	headerCase     HeaderCase
+	indexColumn    bool
+	keyColumns     []string
+	limit          int
+	mergeAllCells  bool
+	mergedColumns  []string
+	nestedDetails  bool
+	nestedTables   int
+	offset         int
+	output         io.Writer
+	pageSize       int
+	path           string
+	rowNumbers     bool
+	rowNumbersFrom int
+	rowSeparators  bool
+	sortedHeaders  bool
+	spewConfig     *spew.ConfigState
+	subtotals      map[string]Aggregate
+	summary        bool
+	terminal       Terminal
+	title          string
+	transpose      bool
+	unifiedDiff    bool
+	zebra          ZebraStyle
 }
 
-// New returns a new Printer, configured with default values:
-func New() *Printer {
+// New returns a new Printer, configured with default values (and then any options):
+func New(options ...Option) *Printer {
 
 	spewConfig := spew.NewDefaultConfig()
 	spewConfig.DisableCapacities = true
@@ -27,30 +59,179 @@ func New() *Printer {
 	spewConfig.SortKeys = true
 	spewConfig.SpewKeys = true
 
-	return &Printer{
-		borders:       false,
-		output:        os.Stdout,
-		sortedHeaders: true,
-		spewConfig:    spewConfig,
+	printer := &Printer{
+		borders:        false,
+		eastAsianWidth: runewidth.EastAsianWidth,
+		output:         os.Stdout,
+		sortedHeaders:  true,
+		spewConfig:     spewConfig,
 	}
+
+	return printer.With(options...)
+}
+
+// With returns a copy of the printer with some options applied (the original printer is never modified):
+func (p *Printer) With(options ...Option) *Printer {
+	printer := *p
+	for _, option := range options {
+		option(&printer)
+	}
+	return &printer
 }
 
-// WithBorders causes the printer to add borders to tables:
+// WithBorders returns a copy of the printer, configured with the WithBorders option:
 func (p *Printer) WithBorders(borders bool) *Printer {
-	p.borders = borders
-	return p
+	return p.With(WithBorders(borders))
+}
+
+// WithCaption returns a copy of the printer, configured with the WithCaption option:
+func (p *Printer) WithCaption(caption string) *Printer {
+	return p.With(WithCaption(caption))
+}
+
+// WithColour returns a copy of the printer, configured with the WithColour option:
+func (p *Printer) WithColour(colour bool) *Printer {
+	return p.With(WithColour(colour))
+}
+
+// WithColumnConfig returns a copy of the printer, configured with the WithColumnConfig option:
+func (p *Printer) WithColumnConfig(header string, config ColumnConfig) *Printer {
+	return p.With(WithColumnConfig(header, config))
+}
+
+// WithColumns returns a copy of the printer, configured with the WithColumns option:
+func (p *Printer) WithColumns(columns ...string) *Printer {
+	return p.With(WithColumns(columns...))
+}
+
+// WithEastAsianWidth returns a copy of the printer, configured with the WithEastAsianWidth option:
+func (p *Printer) WithEastAsianWidth(eastAsianWidth bool) *Printer {
+	return p.With(WithEastAsianWidth(eastAsianWidth))
+}
+
+// WithFlattenedStructs returns a copy of the printer, configured with the WithFlattenedStructs option:
+func (p *Printer) WithFlattenedStructs(flattenStructs bool) *Printer {
+	return p.With(WithFlattenedStructs(flattenStructs))
+}
+
+// WithFooter returns a copy of the printer, configured with the WithFooter option:
+func (p *Printer) WithFooter(aggregates map[string]Aggregate) *Printer {
+	return p.With(WithFooter(aggregates))
+}
+
+// WithGroupBy returns a copy of the printer, configured with the WithGroupBy option:
+func (p *Printer) WithGroupBy(header string) *Printer {
+	return p.With(WithGroupBy(header))
+}
+
+// WithHeaderCase returns a copy of the printer, configured with the WithHeaderCase option:
+func (p *Printer) WithHeaderCase(headerCase HeaderCase) *Printer {
+	return p.With(WithHeaderCase(headerCase))
+}
+
+// WithIndexColumn returns a copy of the printer, configured with the WithIndexColumn option:
+func (p *Printer) WithIndexColumn(indexColumn bool) *Printer {
+	return p.With(WithIndexColumn(indexColumn))
+}
+
+// WithKeyColumns returns a copy of the printer, configured with the WithKeyColumns option:
+func (p *Printer) WithKeyColumns(keyColumns ...string) *Printer {
+	return p.With(WithKeyColumns(keyColumns...))
+}
+
+// WithLimit returns a copy of the printer, configured with the WithLimit option:
+func (p *Printer) WithLimit(limit int) *Printer {
+	return p.With(WithLimit(limit))
+}
+
+// WithMergedCells returns a copy of the printer, configured with the WithMergedCells option:
+func (p *Printer) WithMergedCells(mergeAllCells bool) *Printer {
+	return p.With(WithMergedCells(mergeAllCells))
+}
+
+// WithMergedColumns returns a copy of the printer, configured with the WithMergedColumns option:
+func (p *Printer) WithMergedColumns(headers ...string) *Printer {
+	return p.With(WithMergedColumns(headers...))
 }
 
-// WithOutput adds an output to the printer:
+// WithNestedDetails returns a copy of the printer, configured with the WithNestedDetails option:
+func (p *Printer) WithNestedDetails(nestedDetails bool) *Printer {
+	return p.With(WithNestedDetails(nestedDetails))
+}
+
+// WithNestedTables returns a copy of the printer, configured with the WithNestedTables option:
+func (p *Printer) WithNestedTables(depth int) *Printer {
+	return p.With(WithNestedTables(depth))
+}
+
+// WithOffset returns a copy of the printer, configured with the WithOffset option:
+func (p *Printer) WithOffset(offset int) *Printer {
+	return p.With(WithOffset(offset))
+}
+
+// WithOutput returns a copy of the printer, configured with the WithOutput option:
 func (p *Printer) WithOutput(output io.Writer) *Printer {
-	p.output = output
-	return p
+	return p.With(WithOutput(output))
+}
+
+// WithPageSize returns a copy of the printer, configured with the WithPageSize option:
+func (p *Printer) WithPageSize(pageSize int) *Printer {
+	return p.With(WithPageSize(pageSize))
 }
 
-// WithSortedHeaders causes the printer to alphabetically sort columns by their headers:
+// WithPath returns a copy of the printer, configured with the WithPath option:
+func (p *Printer) WithPath(path string) *Printer {
+	return p.With(WithPath(path))
+}
+
+// WithRowNumbers returns a copy of the printer, configured with the WithRowNumbers option:
+func (p *Printer) WithRowNumbers(start int) *Printer {
+	return p.With(WithRowNumbers(start))
+}
+
+// WithRowSeparators returns a copy of the printer, configured with the WithRowSeparators option:
+func (p *Printer) WithRowSeparators(rowSeparators bool) *Printer {
+	return p.With(WithRowSeparators(rowSeparators))
+}
+
+// WithSortedHeaders returns a copy of the printer, configured with the WithSortedHeaders option:
 func (p *Printer) WithSortedHeaders(sortedHeaders bool) *Printer {
-	p.sortedHeaders = sortedHeaders
-	return p
+	return p.With(WithSortedHeaders(sortedHeaders))
+}
+
+// WithSubtotals returns a copy of the printer, configured with the WithSubtotals option:
+func (p *Printer) WithSubtotals(aggregates map[string]Aggregate) *Printer {
+	return p.With(WithSubtotals(aggregates))
+}
+
+// WithSummary returns a copy of the printer, configured with the WithSummary option:
+func (p *Printer) WithSummary(summary bool) *Printer {
+	return p.With(WithSummary(summary))
+}
+
+// WithTerminal returns a copy of the printer, configured with the WithTerminal option:
+func (p *Printer) WithTerminal(terminal Terminal) *Printer {
+	return p.With(WithTerminal(terminal))
+}
+
+// WithTitle returns a copy of the printer, configured with the WithTitle option:
+func (p *Printer) WithTitle(title string) *Printer {
+	return p.With(WithTitle(title))
+}
+
+// WithTranspose returns a copy of the printer, configured with the WithTranspose option:
+func (p *Printer) WithTranspose(transpose bool) *Printer {
+	return p.With(WithTranspose(transpose))
+}
+
+// WithUnifiedDiff returns a copy of the printer, configured with the WithUnifiedDiff option:
+func (p *Printer) WithUnifiedDiff(unifiedDiff bool) *Printer {
+	return p.With(WithUnifiedDiff(unifiedDiff))
+}
+
+// WithZebra returns a copy of the printer, configured with the WithZebra option:
+func (p *Printer) WithZebra(style ZebraStyle) *Printer {
+	return p.With(WithZebra(style))
 }
 
 // Print marshals an interface and prints it to the configured output:
@@ -74,10 +255,172 @@ func (p *Printer) Print(value interface{}) error {
 func (p *Printer) Marshal(value interface{}) ([]byte, error) {
 
 	// Turn the value into a table:
-	table, err := p.makeTable(value)
+	table, err := p.tableFromValue(value)
 	if err != nil {
 		return nil, err
 	}
 
-	return table.bytes(p.sortedHeaders, p.borders)
+	return p.Render(table)
+}
+
+// PrintTable renders a table and prints it to the configured output:
+func (p *Printer) PrintTable(table *Table) error {
+
+	// Render the table to bytes:
+	renderedBytes, err := p.Render(table)
+	if err != nil {
+		return err
+	}
+
+	// Now print the rendered bytes:
+	if _, err := fmt.Fprint(p.output, string(renderedBytes)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Render turns a table (in the order of its headers) into text:
+func (p *Printer) Render(table *Table) ([]byte, error) {
+	if table == nil {
+		return nil, ErrNoData
+	}
+
+	// Work on a copy (so the table we were given doesn't change):
+	renderTable := *table
+
+	// Only show the rows in the window:
+	p.windowTable(&renderTable)
+	windowNotice := renderTable.windowNotice()
+	summary := renderTable.summary(time.Now())
+
+	// Add a footer:
+	if len(p.footer) > 0 {
+		if err := renderTable.checkAggregates(p.footer); err != nil {
+			return nil, err
+		}
+		renderTable.footer = p.aggregateRow(renderTable.rows, p.footer)
+	}
+
+	// Swap the rows and columns (the footer becomes a column):
+	if p.transpose {
+		transposedTable, err := p.transposeTable(&renderTable)
+		if err != nil {
+			return nil, err
+		}
+		renderTable = *transposedTable
+	}
+
+	// Draw charts:
+	if len(p.columnConfigs) > 0 {
+		renderTable = *p.drawCharts(&renderTable)
+	}
+
+	// Sort the rows into groups:
+	if p.groupBy != "" {
+		groupedTable, err := p.groupRows(&renderTable)
+		if err != nil {
+			return nil, err
+		}
+		renderTable = *groupedTable
+	}
+
+	// Merge repeated values:
+	if p.mergeAllCells || len(p.mergedColumns) > 0 {
+		renderTable = *p.mergeCells(&renderTable)
+	}
+
+	// Number the rows (in the order they are displayed):
+	if p.rowNumbers {
+		renderTable = *p.numberRows(&renderTable)
+	}
+
+	// Wrap values which are wider than their column's MaxWidth:
+	if p.hasWrappedColumns() {
+		renderTable = *p.wrapCells(&renderTable)
+	}
+
+	// Render slices of structs as tables of their own:
+	var details map[int]string
+	if p.nestedTables > 0 {
+		nestedTable, nestedDetails, err := p.nestTables(&renderTable)
+		if err != nil {
+			return nil, err
+		}
+		renderTable, details = *nestedTable, nestedDetails
+	}
+
+	tableBytes, err := renderTable.bytes(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add any detail blocks under their rows:
+	if len(details) > 0 {
+		tableBytes = renderTable.addDetails(tableBytes, p, details)
+	}
+
+	// Add a caption below the table, and a title above it:
+	if p.caption != "" {
+		tableBytes = p.addCaption(tableBytes)
+	}
+	if p.title != "" {
+		tableBytes = p.addTitle(tableBytes)
+	}
+
+	// Say which rows are being shown:
+	tableBytes = append(tableBytes, windowNotice...)
+
+	// Say how many rows there are (and when they were rendered):
+	if p.summary {
+		tableBytes = append(tableBytes, summary...)
+	}
+
+	return tableBytes, nil
+}
+
+// ToTable turns a value into a table (which can be inspected or modified before rendering):
+func (p *Printer) ToTable(value interface{}) (*Table, error) {
+	return p.tableFromValue(value)
+}
+
+// tableFromValue selects the configured part of a value and turns it into a table (with headers in display order):
+func (p *Printer) tableFromValue(value interface{}) (*Table, error) {
+
+	// Select the part of the value we've been asked to print:
+	value, err := p.selectValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Column expressions define their own headers (in the order they were given):
+	if len(p.columns) > 0 {
+		table, err := p.tableFromColumns(value, true)
+		if err != nil {
+			return nil, err
+		}
+		p.windowTable(table)
+		return table, nil
+	}
+
+	// Turn the value into a table (only reflecting the rows we're going to show):
+	table, err := p.makeWindowedTable(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort the headers:
+	if p.sortedHeaders {
+		sort.Strings(table.headers)
+	}
+
+	// The index column always comes first:
+	if p.indexColumn {
+		table.moveHeaderToFront(indexFieldName)
+	}
+
+	// Only keep the rows in the window (if this wasn't done while reflecting them):
+	p.windowTable(table)
+
+	return table, nil
 }
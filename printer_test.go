@@ -69,7 +69,7 @@ var (
 				"age":    7654,
 				"crufty": true,
 			},
-			expectedOutput: "  AGE  | CRUFTY |   NAME     \n+------+--------+-----------+\n  7654 | true   | prawn_map  \n",
+			expectedOutput: "  AGE  | CRUFTY |   NAME     \n+------+--------+-----------+\n  7654 |  true  | prawn_map  \n",
 		},
 		"Basic map with pointers": {
 			inputValue: map[string]interface{}{
@@ -77,7 +77,7 @@ var (
 				"age":    new(int),
 				"crufty": true,
 			},
-			expectedOutput: "  AGE | CRUFTY |   NAME     \n+-----+--------+-----------+\n    0 | true   | prawn_map  \n",
+			expectedOutput: "  AGE | CRUFTY |   NAME     \n+-----+--------+-----------+\n    0 |  true  | prawn_map  \n",
 		},
 	}
 
@@ -108,7 +108,7 @@ var (
 					true,
 				},
 			},
-			expectedOutput: "  AGE  | CRUFTY |      NAME       \n+------+--------+----------------+\n  1000 | true   | prawn_struct_1  \n  2000 | false  | prawn_struct_2  \n  3000 | true   | prawn_struct_3  \n",
+			expectedOutput: "  AGE  | CRUFTY |      NAME       \n+------+--------+----------------+\n  1000 |  true  | prawn_struct_1  \n  2000 | false  | prawn_struct_2  \n  3000 |  true  | prawn_struct_3  \n",
 		},
 		"Slice of pointers": {
 			inputValue: []*struct {
@@ -127,7 +127,7 @@ var (
 					false,
 				},
 			},
-			expectedOutput: "  AGE  | CRUFTY |        NAME         \n+------+--------+--------------------+\n  1000 | true   | prawn_struct_ptr_1  \n  2000 | false  | prawn_struct_ptr_2  \n",
+			expectedOutput: "  AGE  | CRUFTY |        NAME         \n+------+--------+--------------------+\n  1000 |  true  | prawn_struct_ptr_1  \n  2000 | false  | prawn_struct_ptr_2  \n",
 		},
 	}
 
@@ -142,7 +142,7 @@ var (
 				8888,
 				true,
 			},
-			expectedOutput: "  AGE  | CRUFTY |     NAME      \n+------+--------+--------------+\n  8888 | true   | prawn_struct  \n",
+			expectedOutput: "  AGE  | CRUFTY |     NAME      \n+------+--------+--------------+\n  8888 |  true  | prawn_struct  \n",
 		},
 		"Struct pointer": {
 			inputValue: &struct {
@@ -283,3 +283,17 @@ func testDefaultPrinter(t *testing.T) {
 		assert.Equal(t, "+------------+\n|   VALUE    |\n+------------+\n| more cruft |\n+------------+\n", string(marshaledBytes))
 	})
 }
+
+func TestNumericAlignment(t *testing.T) {
+
+	// Large floats are formatted with exponents, but are still numbers:
+	marshaledBytes, err := tableprinter.New().Marshal([]struct {
+		Name   string
+		Weight float64
+	}{
+		{"cruft-1", 1500000},
+		{"cruft-2", 2.5},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "   NAME   | WEIGHT   \n+---------+---------+\n  cruft-1 | 1.5e+06  \n  cruft-2 |     2.5  \n", string(marshaledBytes))
+}
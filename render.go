@@ -0,0 +1,338 @@
+package tableprinter
+
+import (
+	"bytes"
+	"strings"
+)
+
+// textRenderer draws tables as text (with or without borders):
+type textRenderer struct {
+	alignments       []Alignment
+	buffer           bytes.Buffer
+	borders          bool
+	eastAsianWidth   bool
+	headerAlignments []Alignment
+	headerCase       HeaderCase
+	spacing          rowSpacing
+	widths           []int
+	zebraBackground  int
+}
+
+// render draws a table (its headers, rows and footer) as text:
+func (r *textRenderer) render(t *Table) []byte {
+	var headerTexts []string
+	for _, text := range t.sortedHeaderLabels() {
+		headerTexts = append(headerTexts, r.headerCase.format(text))
+	}
+	headerCells := splitCells(headerTexts)
+	var rowCells [][][]string
+	for _, row := range t.rows {
+		rowCells = append(rowCells, splitCells(t.sortRow(row)))
+	}
+	var footerCells [][]string
+	if t.footer != nil {
+		footerCells = splitCells(t.sortRow(t.footer))
+	}
+
+	// Line up the decimal points of numbers (footers included):
+	for columnIndex, columnAlignment := range r.alignments {
+		if columnAlignment == AlignDecimal {
+			alignDecimalPoints(append(rowCells, footerCells), columnIndex)
+		}
+	}
+
+	// Every column is as wide as its widest line of text (headers and footers included):
+	r.widths = make([]int, len(t.headers))
+	for _, cells := range append(append(rowCells, headerCells), footerCells) {
+		for columnIndex, cellLines := range cells {
+			for _, line := range cellLines {
+				if width := r.width(line); width > r.widths[columnIndex] {
+					r.widths[columnIndex] = width
+				}
+			}
+		}
+	}
+
+	// Header groups go above the headers:
+	if t.hasHeaderGroups() {
+		r.writeHeaderGroups(t.headerSpans())
+	}
+
+	// Then the headers:
+	if r.borders {
+		r.writeLine("-")
+	}
+	r.writeHeader(headerCells)
+	r.writeLine("-")
+
+	// Then the rows (and whatever goes between them):
+	for rowIndex, cells := range rowCells {
+		r.writeRow(cells, rowIndex%2 == 1)
+		if r.spacing.linesAfter(rowIndex, len(rowCells)) == 0 {
+			continue
+		}
+		if r.spacing.rowSeparators {
+			r.writeRowSeparator(t.mergedColumns(t.rows[rowIndex+1]))
+			continue
+		}
+		r.writeRow(make([][]string, len(r.widths)), false)
+	}
+
+	// Then the footer (or the bottom border):
+	switch {
+	case footerCells != nil:
+		r.writeFooter(footerCells)
+	case r.borders:
+		r.writeLine("-")
+	}
+
+	return r.buffer.Bytes()
+}
+
+// writeHeaderGroups writes a line of group headers (each centred over the columns in its span):
+func (r *textRenderer) writeHeaderGroups(spans []headerSpan) {
+	var groupBorder, groupLine strings.Builder
+
+	for spanIndex, span := range spans {
+		spanWidth := -1
+		for columnIndex := span.firstColumn; columnIndex <= span.lastColumn; columnIndex++ {
+			spanWidth += r.widths[columnIndex] + 3
+		}
+
+		groupBorder.WriteString("+" + strings.Repeat("-", spanWidth))
+		if spanIndex == 0 && !r.borders {
+			groupLine.WriteString(" ")
+		} else {
+			groupLine.WriteString("|")
+		}
+		groupLine.WriteString(r.pad(r.headerCase.format(span.group), spanWidth, AlignCenter))
+	}
+
+	// Tables with borders get another border above the groups:
+	if r.borders {
+		r.buffer.WriteString(groupBorder.String() + "+\n" + groupLine.String() + "|\n")
+		return
+	}
+	r.buffer.WriteString(groupLine.String() + " \n")
+}
+
+// writeLine writes a horizontal line (eg "+------+-----+"):
+func (r *textRenderer) writeLine(fill string) {
+	r.buffer.WriteString("+")
+	for _, width := range r.widths {
+		r.buffer.WriteString(strings.Repeat(fill, width+2) + "+")
+	}
+	r.buffer.WriteString("\n")
+}
+
+// writeHeader writes the headers:
+func (r *textRenderer) writeHeader(cells [][]string) {
+	for lineIndex := 0; lineIndex < cellHeight(cells); lineIndex++ {
+		r.buffer.WriteString(r.edge())
+		for columnIndex, width := range r.widths {
+			r.buffer.WriteString(" " + r.pad(cellLine(cells[columnIndex], lineIndex), width, r.headerAlignments[columnIndex]) + " ")
+			r.buffer.WriteString(r.separator(columnIndex))
+		}
+		r.buffer.WriteString("\n")
+	}
+}
+
+// writeRow writes a row (which may be several lines high, and may be striped with a background colour):
+func (r *textRenderer) writeRow(cells [][]string, striped bool) {
+	for lineIndex := 0; lineIndex < cellHeight(cells); lineIndex++ {
+		var line strings.Builder
+		line.WriteString(r.edge())
+		for columnIndex, width := range r.widths {
+			line.WriteString(" " + r.pad(cellLine(cells[columnIndex], lineIndex), width, r.alignments[columnIndex]) + " ")
+			line.WriteString(r.separator(columnIndex))
+		}
+
+		if striped && r.zebraBackground > 0 {
+			r.buffer.WriteString(stripe(line.String(), r.zebraBackground) + "\n")
+			continue
+		}
+		r.buffer.WriteString(line.String() + "\n")
+	}
+}
+
+// writeRowSeparator writes a line between rows (which is left open across cells merged into the row above):
+func (r *textRenderer) writeRowSeparator(mergedColumns []bool) {
+	junction := func(columnIndex int) string {
+		if (columnIndex < 0 || mergedColumns[columnIndex]) && (columnIndex == len(mergedColumns)-1 || mergedColumns[columnIndex+1]) {
+			return "|"
+		}
+		return "+"
+	}
+
+	r.buffer.WriteString(junction(-1))
+	for columnIndex, width := range r.widths {
+		fill := "-"
+		if mergedColumns[columnIndex] {
+			fill = " "
+		}
+		r.buffer.WriteString(strings.Repeat(fill, width+2) + junction(columnIndex))
+	}
+	r.buffer.WriteString("\n")
+}
+
+// writeFooter writes the footer (right-aligned, with the separators and line around empty cells left out). Tables with
+// borders get a double line above their footer (instead of a bottom border), so it can't be mistaken for a row:
+func (r *textRenderer) writeFooter(cells [][]string) {
+	if r.borders {
+		r.writeLine("=")
+	} else {
+		r.writeLine("-")
+	}
+
+	// Empty cells (and the separators after them) are left blank:
+	var blankColumns = make([]bool, len(cells))
+	for columnIndex := range cells {
+		blankColumns[columnIndex] = cellLine(cells[columnIndex], 0) == ""
+	}
+	for lineIndex := 0; lineIndex < cellHeight(cells); lineIndex++ {
+		r.buffer.WriteString(r.edge())
+		for columnIndex, width := range r.widths {
+			r.buffer.WriteString(" " + r.pad(cellLine(cells[columnIndex], lineIndex), width, AlignRight) + " ")
+			if blankColumns[columnIndex] {
+				r.buffer.WriteString(" ")
+				continue
+			}
+			r.buffer.WriteString(r.separator(columnIndex))
+		}
+		r.buffer.WriteString("\n")
+	}
+
+	// The line under the footer only starts at the first non-empty cell (unless there are borders):
+	var started = r.borders
+	for columnIndex, width := range r.widths {
+		if !blankColumns[columnIndex] {
+			started = true
+		}
+		fill, junction := "-", "+"
+		if !started {
+			fill, junction = " ", " "
+			if columnIndex+1 < len(blankColumns) && !blankColumns[columnIndex+1] {
+				junction = "+"
+			}
+		}
+		if columnIndex == 0 {
+			if blankColumns[0] && !r.borders {
+				r.buffer.WriteString(" ")
+			} else {
+				r.buffer.WriteString("+")
+			}
+		}
+		r.buffer.WriteString(strings.Repeat(fill, width+2) + junction)
+	}
+	r.buffer.WriteString("\n")
+}
+
+// edge is the start of each line:
+func (r *textRenderer) edge() string {
+	if r.borders {
+		return "|"
+	}
+	return " "
+}
+
+// separator goes after each column (tables without borders don't have one after the last column):
+func (r *textRenderer) separator(columnIndex int) string {
+	if columnIndex == len(r.widths)-1 && !r.borders {
+		return " "
+	}
+	return "|"
+}
+
+// width measures text in terminal columns:
+func (r *textRenderer) width(text string) int {
+	return displayWidth(text, r.eastAsianWidth)
+}
+
+// pad aligns text within a width:
+func (r *textRenderer) pad(text string, width int, textAlignment Alignment) string {
+	gap := width - r.width(text)
+	if gap <= 0 {
+		return text
+	}
+
+	switch textAlignment {
+	case AlignCenter:
+		return strings.Repeat(" ", gap/2) + text + strings.Repeat(" ", gap-gap/2)
+	case AlignRight, AlignDecimal:
+		return strings.Repeat(" ", gap) + text
+	case AlignLeft:
+		return text + strings.Repeat(" ", gap)
+	default:
+		if numericValue.MatchString(strings.TrimSpace(text)) {
+			return strings.Repeat(" ", gap) + text
+		}
+		return text + strings.Repeat(" ", gap)
+	}
+}
+
+// alignDecimalPoints pads the numbers in a column with trailing spaces, so that their decimal points line up once they
+// are right-aligned:
+func alignDecimalPoints(rowCells [][][]string, columnIndex int) {
+	var maxFractionWidth int
+	for _, cells := range rowCells {
+		if cells == nil {
+			continue
+		}
+		for _, line := range cells[columnIndex] {
+			if fractionWidth := decimalFractionWidth(line); fractionWidth > maxFractionWidth {
+				maxFractionWidth = fractionWidth
+			}
+		}
+	}
+
+	for _, cells := range rowCells {
+		if cells == nil {
+			continue
+		}
+		for lineIndex, line := range cells[columnIndex] {
+			if numericValue.MatchString(strings.TrimSpace(line)) {
+				cells[columnIndex][lineIndex] = line + strings.Repeat(" ", maxFractionWidth-decimalFractionWidth(line))
+			}
+		}
+	}
+}
+
+// decimalFractionWidth is the width of the decimal point and the digits after it (or 0 for whole numbers and text):
+func decimalFractionWidth(text string) int {
+	text = strings.TrimSpace(text)
+	if !numericValue.MatchString(text) {
+		return 0
+	}
+	if pointIndex := strings.LastIndex(text, "."); pointIndex >= 0 {
+		return len(text) - pointIndex
+	}
+	return 0
+}
+
+// splitCells splits the text of each cell into lines:
+func splitCells(texts []string) [][]string {
+	var cells [][]string
+	for _, text := range texts {
+		cells = append(cells, strings.Split(text, "\n"))
+	}
+	return cells
+}
+
+// cellHeight is the number of lines the tallest cell takes up:
+func cellHeight(cells [][]string) int {
+	var height = 1
+	for _, cellLines := range cells {
+		if len(cellLines) > height {
+			height = len(cellLines)
+		}
+	}
+	return height
+}
+
+// cellLine returns a line of a cell (or nothing, for cells which aren't that tall):
+func cellLine(cellLines []string, lineIndex int) string {
+	if lineIndex < len(cellLines) {
+		return cellLines[lineIndex]
+	}
+	return ""
+}
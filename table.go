@@ -1,92 +1,338 @@
 package tableprinter
 
 import (
-	"bytes"
-	"sort"
+	"reflect"
+	"regexp"
 	"strings"
-
-	"github.com/olekukonko/tablewriter"
 )
 
 const (
 	// defaultFieldName is the column header for individual values that have no field name:
 	defaultFieldName     = "value"
+	indexFieldName       = "index"
 	nilFieldValue        = "<nil>"
+	rowNumberHeader      = "#"
 	spewPointerString    = "<*>"
 	unexportedFieldValue = "<unexported>"
 )
 
-// tableRow is a map of fields which make up a row:
-type tableRow map[string]string
+var (
+	// ansiEscapeSequence matches terminal control sequences which don't take up any space (CSI sequences like the colour
+	// codes we add to values, and OSC sequences like hyperlinks):
+	ansiEscapeSequence = regexp.MustCompile("\x1b\\[[0-?]*[ -/]*[@-~]|\x1b\\][^\x07\x1b]*(?:\x07|\x1b\\\\)")
+
+	// tableFormatter formats the values added to hand-built tables (with the default options, however the default printer
+	// has been configured):
+	tableFormatter = New()
+
+	// numericValue matches values which look like numbers (and are right-aligned):
+	numericValue = regexp.MustCompile(`^-?(?:\d{1,3}(?:,\d{3})*|\d+)(?:\.\d+)?$`)
+)
+
+// tableRow is a map of fields (cells) which make up a row:
+type tableRow map[string]cell
 
-// table is an in-memory representation of a table:
-type table struct {
+// Table is an in-memory representation of a table (which can be built by hand, or made from a value with Printer.ToTable()):
+type Table struct {
+	firstRow     int
+	footer       tableRow
+	headerGroups map[string]string
+	headerLabels map[string]string
 	headers      []string
 	rows         []tableRow
 	maxRowLength int
+	totalRows    int
+}
+
+// NewTable returns an empty table with the given column headers (which have to be different from each other):
+func NewTable(headers ...string) (*Table, error) {
+	var table = new(Table)
+	for _, header := range headers {
+		if err := table.AddColumn(header); err != nil {
+			return nil, err
+		}
+	}
+	return table, nil
+}
+
+// AddColumn adds a column to the end of the table (existing rows have an empty value in it):
+func (t *Table) AddColumn(header string) error {
+	if t.hasHeader(header) {
+		return ErrDuplicateColumn
+	}
+	t.addHeader(header)
+	return nil
+}
+
+// AddRow appends a row of values (in the same order as the columns), formatting them in the same way as a new printer:
+func (t *Table) AddRow(values ...interface{}) error {
+	var row = make(tableRow)
+
+	if len(values) > len(t.headers) {
+		return ErrTooManyValues
+	}
+	for valueIndex, value := range values {
+		row.setField(t.headers[valueIndex], tableFormatter.cellFromValue(value))
+	}
+
+	t.addRow(row)
+	return nil
+}
+
+// Cell returns the value of a cell (and whether there is such a cell):
+func (t *Table) Cell(rowIndex int, header string) (string, bool) {
+	if rowIndex < 0 || rowIndex >= len(t.rows) || !t.hasHeader(header) {
+		return "", false
+	}
+	return t.rows[rowIndex][header].text, true
+}
+
+// Value returns the original value of a cell (and whether there is such a cell):
+func (t *Table) Value(rowIndex int, header string) (interface{}, bool) {
+	if _, ok := t.Cell(rowIndex, header); !ok {
+		return nil, false
+	}
+	return t.rows[rowIndex][header].value, true
+}
+
+// Headers returns the column headers (in the order they will be rendered):
+func (t *Table) Headers() []string {
+	return append([]string{}, t.headers...)
+}
+
+// NumRows returns the number of rows in the table:
+func (t *Table) NumRows() int {
+	return len(t.rows)
 }
 
-// setField sets a named field with a given value:
-func (r tableRow) setField(field, value string) {
-	r[field] = strings.ReplaceAll(value, spewPointerString, "")
+// RemoveColumn removes a column (and its values) from the table:
+func (t *Table) RemoveColumn(header string) error {
+	for headerIndex, existingHeader := range t.headers {
+		if existingHeader == header {
+			t.headers = append(t.headers[:headerIndex:headerIndex], t.headers[headerIndex+1:]...)
+			for _, row := range t.rows {
+				delete(row, header)
+			}
+			return nil
+		}
+	}
+	return ErrNoSuchCell
+}
+
+// SetCell replaces the value of a cell, formatting it in the same way as a new printer:
+func (t *Table) SetCell(rowIndex int, header string, value interface{}) error {
+	if _, ok := t.Cell(rowIndex, header); !ok {
+		return ErrNoSuchCell
+	}
+	t.rows[rowIndex].setField(header, tableFormatter.cellFromValue(value))
+	return nil
+}
+
+// SetHeaderGroup puts some columns into a group, which is rendered as a header spanning them (columns in a group should be next to each other):
+func (t *Table) SetHeaderGroup(group string, headers ...string) error {
+	for _, header := range headers {
+		if !t.hasHeader(header) {
+			return ErrNoSuchCell
+		}
+	}
+	for _, header := range headers {
+		t.setHeaderGroup(header, group, t.headerLabel(header))
+	}
+	return nil
+}
+
+// hasHeader determines whether the table has a column:
+func (t *Table) hasHeader(header string) bool {
+	for _, existingHeader := range t.headers {
+		if existingHeader == header {
+			return true
+		}
+	}
+	return false
+}
+
+// setField sets a named field with a given cell:
+func (r tableRow) setField(field string, value cell) {
+	r[field] = value
+}
+
+// height is the number of lines a row takes up when rendered:
+func (r tableRow) height() int {
+	var height = 1
+	for _, value := range r {
+		if lines := strings.Count(value.text, "\n") + 1; lines > height {
+			height = lines
+		}
+	}
+	return height
+}
+
+// setHeaderGroup puts a column into a group (and gives it a label to display instead of its header):
+func (t *Table) setHeaderGroup(header, group, label string) {
+	if t.headerGroups == nil {
+		t.headerGroups = make(map[string]string)
+		t.headerLabels = make(map[string]string)
+	}
+	t.headerGroups[header] = group
+	t.headerLabels[header] = label
+}
+
+// headerLabel is how a column header is displayed:
+func (t *Table) headerLabel(header string) string {
+	if label, ok := t.headerLabels[header]; ok {
+		return label
+	}
+	return header
+}
+
+// sortedHeaderLabels returns the display labels of the headers (in order):
+func (t *Table) sortedHeaderLabels() []string {
+	var labels []string
+	for _, header := range t.headers {
+		labels = append(labels, t.headerLabel(header))
+	}
+	return labels
+}
+
+// adoptHeaders takes the headers (and header groups) of another table:
+func (t *Table) adoptHeaders(other *Table) {
+	t.headers = other.headers
+	t.headerGroups = other.headerGroups
+	t.headerLabels = other.headerLabels
 }
 
 // addHeader adds a header field:
-func (t *table) addHeader(header string) {
+func (t *Table) addHeader(header string) {
 	t.headers = append(t.headers, header)
 }
 
+// moveHeaderToFront makes a column the first one (if the table has it):
+func (t *Table) moveHeaderToFront(header string) {
+	for headerIndex, existingHeader := range t.headers {
+		if existingHeader == header {
+			t.headers = append([]string{header}, append(t.headers[:headerIndex:headerIndex], t.headers[headerIndex+1:]...)...)
+			return
+		}
+	}
+}
+
 // addRow appends a new row to our list:
-func (t *table) addRow(row tableRow) {
+func (t *Table) addRow(row tableRow) {
 	t.rows = append(t.rows, row)
 }
 
+// headerLines is the number of lines a rendered table takes up before the first row:
+func (t *Table) headerLines(borders bool) int {
+	var headerLines = 2
+	if borders {
+		headerLines = 3
+	}
+
+	// Header groups take up another line (and another border):
+	if t.hasHeaderGroups() {
+		headerLines++
+		if borders {
+			headerLines++
+		}
+	}
+
+	return headerLines
+}
+
 // bytes renders a table as bytes:
-func (t *table) bytes(sortedHeaders, borders bool) ([]byte, error) {
+func (t *Table) bytes(p *Printer) ([]byte, error) {
 
 	// Make sure we actually have some data:
 	if len(t.rows) == 0 {
 		return nil, ErrNoData
 	}
 
-	// Create a buffer for the output (so we can collect what gets printed):
-	tableBuffer := bytes.NewBuffer(nil)
+	renderer := &textRenderer{
+		alignments:       p.columnAlignments(t),
+		borders:          p.borders,
+		eastAsianWidth:   p.eastAsianWidth,
+		headerAlignments: p.headerAlignments(t),
+		headerCase:       p.headerCase,
+		spacing:          p.rowSpacing(),
+		zebraBackground:  p.zebraBackground(),
+	}
+	return renderer.render(t), nil
+}
+
+// columnAlignments works out how to align the values in each column. Unless a column is configured otherwise, columns
+// of numbers (or text which looks like numbers once colour codes are removed) are right-aligned, columns of bools are
+// centred, and anything else is aligned cell by cell:
+func (p *Printer) columnAlignments(t *Table) []Alignment {
+	var alignments []Alignment
 
-	// Use a tablewriter:
-	tw := tablewriter.NewWriter(tableBuffer)
+	for _, header := range t.headers {
+		if columnAlignment := p.columnConfig(header).Align; columnAlignment != AlignAuto {
+			alignments = append(alignments, columnAlignment)
+			continue
+		}
 
-	// Sort the headers:
-	if sortedHeaders {
-		sort.Strings(t.headers)
-	}
+		numeric, boolean := true, true
+		for _, row := range t.rows {
+			value := strings.TrimSpace(ansiEscapeSequence.ReplaceAllString(row[header].text, ""))
+			if value == "" {
+				continue
+			}
+			if !row[header].isNumeric() && !numericValue.MatchString(value) {
+				numeric = false
+			}
+			if row[header].kind != reflect.Bool {
+				boolean = false
+			}
+		}
 
-	// Add the headers:
-	tw.SetHeader(t.headers)
+		switch {
+		case numeric:
+			alignments = append(alignments, AlignRight)
+		case boolean:
+			alignments = append(alignments, AlignCenter)
+		default:
+			alignments = append(alignments, AlignAuto)
+		}
+	}
 
-	// Tables without borders:
-	tw.SetBorder(borders)
+	return alignments
+}
 
-	tw.SetAutoWrapText(false)
+// headerAlignments works out how to align each header (centred, unless a column is configured otherwise):
+func (p *Printer) headerAlignments(t *Table) []Alignment {
+	var alignments []Alignment
 
-	// Append the rows:
-	for _, row := range t.rows {
-		tw.Append(t.sortRow(row))
+	for _, header := range t.headers {
+		headerAlignment := p.columnConfig(header).HeaderAlign
+		if headerAlignment == AlignAuto {
+			headerAlignment = AlignCenter
+		}
+		alignments = append(alignments, headerAlignment)
 	}
 
-	// Render the table:
-	tw.Render()
+	return alignments
+}
 
-	// Just return whatever was rendered to the buffer:
-	return tableBuffer.Bytes(), nil
+// mergedColumns says which cells of a row (in the order of the headers) are merged with the ones above them:
+func (t *Table) mergedColumns(row tableRow) []bool {
+	var mergedColumns []bool
+	for _, header := range t.headers {
+		mergedColumns = append(mergedColumns, row[header].merged)
+	}
+	return mergedColumns
 }
 
 // sortRow returns a row in the corrent order (according to the header):
-func (t *table) sortRow(row tableRow) []string {
+func (t *Table) sortRow(row tableRow) []string {
 	var sortedRow []string
 
-	// Add the row fields in the same order as the headers:
+	// Add the row fields in the same order as the headers (merged cells are left empty):
 	for _, header := range t.headers {
-		sortedRow = append(sortedRow, row[header])
+		if row[header].merged {
+			sortedRow = append(sortedRow, "")
+			continue
+		}
+		sortedRow = append(sortedRow, row[header].text)
 	}
 
 	return sortedRow
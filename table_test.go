@@ -0,0 +1,91 @@
+package tableprinter_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableBuilder(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	tablePrinter := tableprinter.New().WithOutput(outputBuffer)
+
+	// Build a table by hand:
+	table, err := tableprinter.NewTable("Name", "Weight")
+	assert.NoError(t, err)
+	assert.NoError(t, table.AddRow("cruft-1", 5))
+	assert.NoError(t, table.AddRow("cruft-2"))
+	assert.Equal(t, tableprinter.ErrTooManyValues, table.AddRow("cruft-3", 7, true))
+
+	// Add a column, then fill it in:
+	assert.NoError(t, table.AddColumn("Crufty"))
+	assert.Equal(t, tableprinter.ErrDuplicateColumn, table.AddColumn("Crufty"))
+	assert.NoError(t, table.SetCell(0, "Crufty", true))
+	assert.NoError(t, table.SetCell(1, "Crufty", new(bool)))
+	assert.Equal(t, tableprinter.ErrNoSuchCell, table.SetCell(2, "Crufty", true))
+	assert.Equal(t, tableprinter.ErrNoSuchCell, table.SetCell(0, "Cruftiness", true))
+
+	// Inspect it:
+	assert.Equal(t, []string{"Name", "Weight", "Crufty"}, table.Headers())
+	assert.Equal(t, 2, table.NumRows())
+	value, ok := table.Cell(1, "Crufty")
+	assert.True(t, ok)
+	assert.Equal(t, "false", value)
+	originalValue, ok := table.Value(1, "Crufty")
+	assert.True(t, ok)
+	assert.Equal(t, new(bool), originalValue)
+	_, ok = table.Cell(1, "Cruftiness")
+	assert.False(t, ok)
+
+	// Print it (columns stay in the order they were added):
+	err = tablePrinter.PrintTable(table)
+	assert.NoError(t, err)
+	assert.Equal(t, "   NAME   | WEIGHT | CRUFTY  \n+---------+--------+--------+\n  cruft-1 |      5 | true    \n  cruft-2 |        | false   \n", outputBuffer.String())
+}
+
+func TestToTable(t *testing.T) {
+	tablePrinter := tableprinter.New().WithBorders(true)
+
+	// Reflect a value into a table:
+	table, err := tablePrinter.ToTable([]struct {
+		Name   string
+		Weight int
+	}{
+		{"cruft-1", 5},
+		{"cruft-2", 6},
+	})
+	assert.NoError(t, err)
+
+	// Cells keep their original values:
+	value, ok := table.Value(1, "Weight")
+	assert.True(t, ok)
+	assert.Equal(t, 6, value)
+	_, ok = table.Value(2, "Weight")
+	assert.False(t, ok)
+
+	// Post-process it before rendering:
+	assert.NoError(t, table.RemoveColumn("Weight"))
+	assert.Equal(t, tableprinter.ErrNoSuchCell, table.RemoveColumn("Weight"))
+	assert.NoError(t, table.SetCell(1, "Name", "prawn"))
+
+	renderedBytes, err := tablePrinter.Render(table)
+	assert.NoError(t, err)
+	assert.Equal(t, "+---------+\n|  NAME   |\n+---------+\n| cruft-1 |\n| prawn   |\n+---------+\n", string(renderedBytes))
+
+	// Empty tables have nothing to render:
+	emptyTable, err := tableprinter.NewTable("Name")
+	assert.NoError(t, err)
+	_, err = tablePrinter.Render(emptyTable)
+	assert.Equal(t, tableprinter.ErrNoData, err)
+
+	// Neither do missing tables:
+	_, err = tablePrinter.Render(nil)
+	assert.Equal(t, tableprinter.ErrNoData, err)
+	assert.Equal(t, tableprinter.ErrNoData, tablePrinter.PrintTable(nil))
+
+	// Columns have to be different from each other:
+	_, err = tableprinter.NewTable("Name", "Name")
+	assert.Equal(t, tableprinter.ErrDuplicateColumn, err)
+}
@@ -2,13 +2,23 @@ package tableprinter
 
 import (
 	"reflect"
+	"sort"
+	"strings"
 )
 
 type stringable interface {
 	String() string
 }
 
-func (p *Printer) makeTable(value interface{}) (*table, error) {
+// isStructType determines whether a type is a struct (or a pointer to one):
+func isStructType(reflectedType reflect.Type) bool {
+	if reflectedType.Kind() == reflect.Ptr {
+		reflectedType = reflectedType.Elem()
+	}
+	return reflectedType.Kind() == reflect.Struct
+}
+
+func (p *Printer) makeTable(value interface{}) (*Table, error) {
 
 	// Check that we've not been given a nil value:
 	if value == nil {
@@ -23,8 +33,11 @@ func (p *Printer) makeTable(value interface{}) (*table, error) {
 	// Take a different approach depending on the type of data that was provided:
 	switch reflect.TypeOf(value).Kind() {
 
-	// Maps get turned into a single-row table:
+	// Maps of structs get turned into a multi-row table, other maps get turned into a single-row table:
 	case reflect.Map:
+		if isStructType(reflect.TypeOf(value).Elem()) {
+			return p.tableFromStructMapValue(value)
+		}
 		return p.tableFromMapValue(value)
 
 	// For pointers we just recurse on their interface:
@@ -56,21 +69,26 @@ func (p *Printer) formatValue(value interface{}) string {
 	return p.spewConfig.Sprintf("%v", value)
 }
 
+// cellFromValue makes a cell from a value (formatted for display):
+func (p *Printer) cellFromValue(value interface{}) cell {
+	return newCell(value, p.formatValue(value))
+}
+
 // tableFromBasicValue turns an interface into a single column in a single row:
-func (p *Printer) tableFromBasicValue(value interface{}) (*table, error) {
-	var table = new(table)
+func (p *Printer) tableFromBasicValue(value interface{}) (*Table, error) {
+	var table = new(Table)
 	var row = make(tableRow)
 
 	// Just add the one value:
 	table.addHeader(defaultFieldName)
-	row.setField(defaultFieldName, p.formatValue(value))
+	row.setField(defaultFieldName, p.cellFromValue(value))
 	table.addRow(row)
 	return table, nil
 }
 
 // tableFromMapValue turns a map into a single-row table:
-func (p *Printer) tableFromMapValue(value interface{}) (*table, error) {
-	var table = new(table)
+func (p *Printer) tableFromMapValue(value interface{}) (*Table, error) {
+	var table = new(Table)
 	var row = make(tableRow)
 
 	// Turn the value into a map[string]interface{}:
@@ -82,16 +100,23 @@ func (p *Printer) tableFromMapValue(value interface{}) (*table, error) {
 	// Add the map fields to the table:
 	for fieldName, fieldValue := range assertedMap {
 		table.addHeader(fieldName)
+
+		// Nil values (eg JSON nulls) don't have a type:
+		if fieldValue == nil {
+			row.setField(fieldName, textCell(nilFieldValue))
+			continue
+		}
+
 		switch reflect.TypeOf(fieldValue).Kind() {
 		case reflect.Ptr:
 			reflectedFieldValue := reflect.ValueOf(fieldValue).Elem()
 			if reflectedFieldValue.CanInterface() {
-				row.setField(fieldName, p.formatValue(reflectedFieldValue.Interface()))
+				row.setField(fieldName, p.cellFromValue(reflectedFieldValue.Interface()))
 				continue
 			}
-			row.setField(fieldName, p.formatValue(reflectedFieldValue))
+			row.setField(fieldName, textCell(p.formatValue(reflectedFieldValue)))
 		default:
-			row.setField(fieldName, p.formatValue(fieldValue))
+			row.setField(fieldName, p.cellFromValue(fieldValue))
 		}
 	}
 
@@ -101,65 +126,156 @@ func (p *Printer) tableFromMapValue(value interface{}) (*table, error) {
 }
 
 // tableFromSliceValue turns a slice into a multi-row table:
-func (p *Printer) tableFromSliceValue(value interface{}) (*table, error) {
-	var table = new(table)
+func (p *Printer) tableFromSliceValue(value interface{}) (*Table, error) {
 
 	// Reflect the value to gain access to its elements:
 	reflectedValue := reflect.ValueOf(value)
 
+	return p.tableFromSliceRange(reflectedValue, 0, reflectedValue.Len())
+}
+
+// tableFromSliceRange turns some of the elements of a slice into a multi-row table (without reflecting the others):
+func (p *Printer) tableFromSliceRange(reflectedValue reflect.Value, start, end int) (*Table, error) {
+	var table = new(Table)
+
 	// Turn each entry into a table (with a row that we can take):
-	for i := 0; i < reflectedValue.Len(); i++ {
+	for i := start; i < end; i++ {
 		tempTable, err := p.makeTable(reflectedValue.Index(i).Interface())
 		if err != nil {
 			return nil, err
 		}
 
 		// Add the new row and headers to our table:
-		table.headers = tempTable.headers
+		table.adoptHeaders(tempTable)
 		table.addRow(tempTable.rows[0])
+
+		// Keep track of where each row came from:
+		if p.indexColumn {
+			table.headers = append([]string{indexFieldName}, tempTable.headers...)
+			tempTable.rows[0].setField(indexFieldName, p.cellFromValue(i))
+		}
 	}
 
 	return table, nil
 }
 
-// tableFromStructValue turns a struct into a single-row table:
-func (p *Printer) tableFromStructValue(value interface{}) (*table, error) {
-	var table = new(table)
-	var row = make(tableRow)
+// tableFromStructMapValue turns a map of structs into a multi-row table (ordered by key):
+func (p *Printer) tableFromStructMapValue(value interface{}) (*Table, error) {
+	var table = new(Table)
 
-	// Reflect the value to gain access to its elements:
-	reflectedType := reflect.TypeOf(value)
+	// Reflect the value to gain access to its keys:
 	reflectedValue := reflect.ValueOf(value)
+	keys := reflectedValue.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return formatKey(keys[i]) < formatKey(keys[j])
+	})
+
+	// Turn each entry into a table (with a row that we can take):
+	for _, key := range keys {
+		tempTable, err := p.makeTable(reflectedValue.MapIndex(key).Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		// Add the new row and headers to our table:
+		table.adoptHeaders(tempTable)
+		table.addRow(tempTable.rows[0])
+
+		// Keep track of where each row came from:
+		if p.indexColumn {
+			table.headers = append([]string{indexFieldName}, tempTable.headers...)
+			tempTable.rows[0].setField(indexFieldName, p.cellFromValue(key.Interface()))
+		}
+	}
+
+	return table, nil
+}
+
+// tableFromStructValue turns a struct into a single-row table:
+func (p *Printer) tableFromStructValue(value interface{}) (*Table, error) {
+	var table = new(Table)
+	var row = make(tableRow)
 
 	// Add the struct fields to the table:
+	p.addStructFields(table, row, "", "", reflect.TypeOf(value), reflect.ValueOf(value))
+
+	// Add the row to the table:
+	table.addRow(row)
+	return table, nil
+}
+
+// addStructFields adds the fields of a struct to a table (and a row). Nested structs can be flattened into a group of
+// columns (eg "Resources.CPU" and "Resources.Memory"), in which case the value may be invalid (from a nil pointer):
+func (p *Printer) addStructFields(table *Table, row tableRow, prefix, group string, reflectedType reflect.Type, reflectedValue reflect.Value) {
 	for i := 0; i < reflectedType.NumField(); i++ {
 		fieldName := reflectedType.Field(i).Name
-		fieldValue := reflectedValue.Field(i)
+		fieldType := reflectedType.Field(i).Type
+		if prefix != "" {
+			fieldName = prefix + "." + fieldName
+		}
+
+		// Flatten nested structs into their own columns (grouped under the top-level field):
+		if p.flattenStructs && reflectedType.Field(i).PkgPath == "" && isFlattenableType(fieldType) {
+			nestedGroup, nestedValue := group, reflect.Value{}
+			if nestedGroup == "" {
+				nestedGroup = fieldName
+			}
+			if reflectedValue.IsValid() {
+				nestedValue = indirect(reflectedValue.Field(i))
+			}
+			p.addStructFields(table, row, fieldName, nestedGroup, indirectType(fieldType), nestedValue)
+			continue
+		}
+
 		table.addHeader(fieldName)
+		if group != "" {
+			table.setHeaderGroup(fieldName, group, strings.TrimPrefix(fieldName, group+"."))
+		}
+
+		// Fields of nil nested structs are nil too:
+		if !reflectedValue.IsValid() {
+			row.setField(fieldName, textCell(nilFieldValue))
+			continue
+		}
+		fieldValue := reflectedValue.Field(i)
 
 		// We can only work with exported fields:
 		if !fieldValue.CanInterface() {
-			row.setField(fieldName, unexportedFieldValue)
+			row.setField(fieldName, textCell(unexportedFieldValue))
 			continue
 		}
 
 		// Type switch:
-		switch reflectedType.Field(i).Type.Kind() {
+		switch fieldType.Kind() {
 
 		// Pointers can be nil, so we need to check this (or just take the Elem() value):
 		case reflect.Ptr:
 			if fieldValue.IsNil() {
-				row.setField(fieldName, nilFieldValue)
+				row.setField(fieldName, textCell(nilFieldValue))
 				continue
 			}
-			row.setField(fieldName, p.formatValue(fieldValue.Elem().Interface()))
+			row.setField(fieldName, p.cellFromValue(fieldValue.Elem().Interface()))
 
 		default:
-			row.setField(fieldName, p.formatValue(fieldValue.Interface()))
+			row.setField(fieldName, p.cellFromValue(fieldValue.Interface()))
 		}
 	}
+}
 
-	// Add the row to the table:
-	table.addRow(row)
-	return table, nil
+// isFlattenableType determines whether a type is a struct (or a pointer to one) which doesn't know how to display itself:
+func isFlattenableType(reflectedType reflect.Type) bool {
+	reflectedType = indirectType(reflectedType)
+	stringableType := reflect.TypeOf((*stringable)(nil)).Elem()
+
+	return reflectedType.Kind() == reflect.Struct &&
+		!reflectedType.Implements(stringableType) &&
+		!reflect.PtrTo(reflectedType).Implements(stringableType)
+}
+
+// indirectType returns the type a pointer points to (or the type itself if it isn't a pointer):
+func indirectType(reflectedType reflect.Type) reflect.Type {
+	if reflectedType.Kind() == reflect.Ptr {
+		return reflectedType.Elem()
+	}
+	return reflectedType
 }
@@ -0,0 +1,184 @@
+package tableprinter
+
+import (
+	"bufio"
+	"io"
+	"os"
+)
+
+const (
+	ansiAlternateScreen = "\x1b[?1049h"
+	ansiBackground      = "\x1b[48;5;%dm"
+	ansiClearDown       = "\x1b[J"
+	ansiClearLine       = "\x1b[K"
+	ansiClearScreen     = "\x1b[H\x1b[2J"
+	ansiCursorUp        = "\x1b[%dA"
+	ansiGreen           = "\x1b[32m"
+	ansiHideCursor      = "\x1b[?25l"
+	ansiMainScreen      = "\x1b[?1049l"
+	ansiRed             = "\x1b[31m"
+	ansiReset           = "\x1b[0m"
+	ansiReverse         = "\x1b[7m"
+	ansiReverseOff      = "\x1b[27m"
+	ansiShowCursor      = "\x1b[?25h"
+	ansiYellow          = "\x1b[33m"
+)
+
+// colourise wraps some (non-empty) text in an ANSI colour:
+func colourise(colour, text string) string {
+	if text == "" {
+		return text
+	}
+	return colour + text + ansiReset
+}
+
+// Terminal is a raw ANSI terminal (the interactive pager reads keypresses from it and draws on it):
+type Terminal interface {
+	io.ReadWriter
+
+	// MakeRaw puts the terminal into raw mode, returning a function which restores its previous state:
+	MakeRaw() (restore func() error, err error)
+
+	// Size returns the number of columns and rows on the terminal:
+	Size() (width, height int, err error)
+}
+
+// fileTerminal is a Terminal made from a pair of files (normally stdin and stdout):
+type fileTerminal struct {
+	input  *os.File
+	output *os.File
+}
+
+// newTerminal returns a Terminal which uses stdin and stdout:
+func newTerminal() Terminal {
+	return &fileTerminal{
+		input:  os.Stdin,
+		output: os.Stdout,
+	}
+}
+
+// Read reads keypresses from the terminal:
+func (t *fileTerminal) Read(p []byte) (int, error) {
+	return t.input.Read(p)
+}
+
+// Write draws on the terminal:
+func (t *fileTerminal) Write(p []byte) (int, error) {
+	return t.output.Write(p)
+}
+
+// MakeRaw puts the input side of the terminal into raw mode:
+func (t *fileTerminal) MakeRaw() (func() error, error) {
+	return makeRaw(t.input.Fd())
+}
+
+// Size returns the dimensions of the output side of the terminal:
+func (t *fileTerminal) Size() (int, int, error) {
+	return terminalSize(t.output.Fd())
+}
+
+// key identifies a keypress which isn't just a printable character:
+type key int
+
+const (
+	keyRune key = iota
+	keyBackspace
+	keyDown
+	keyEnd
+	keyEnter
+	keyEscape
+	keyHome
+	keyInterrupt
+	keyLeft
+	keyPageDown
+	keyPageUp
+	keyRight
+	keyTab
+	keyUp
+)
+
+// keyPress is a single keypress (either a special key or a printable character):
+type keyPress struct {
+	key  key
+	char rune
+}
+
+// readKey reads one keypress (decoding ANSI escape sequences for the cursor keys):
+func readKey(reader *bufio.Reader) (keyPress, error) {
+	char, _, err := reader.ReadRune()
+	if err != nil {
+		return keyPress{}, err
+	}
+
+	switch char {
+	case '\x03':
+		return keyPress{key: keyInterrupt}, nil
+	case '\x08', '\x7f':
+		return keyPress{key: keyBackspace}, nil
+	case '\t':
+		return keyPress{key: keyTab}, nil
+	case '\r', '\n':
+		return keyPress{key: keyEnter}, nil
+	case '\x1b':
+		return readEscapeSequence(reader)
+	}
+
+	return keyPress{key: keyRune, char: char}, nil
+}
+
+// readEscapeSequence decodes the rest of an escape sequence (a lone escape is just the escape key):
+func readEscapeSequence(reader *bufio.Reader) (keyPress, error) {
+
+	// Escape sequences arrive all at once, so anything else has to be a separate keypress:
+	if reader.Buffered() == 0 {
+		return keyPress{key: keyEscape}, nil
+	}
+	if next, err := reader.Peek(1); err != nil || (next[0] != '[' && next[0] != 'O') {
+		return keyPress{key: keyEscape}, nil
+	}
+	reader.ReadByte()
+
+	// Collect parameters until we reach the final byte of the sequence:
+	var parameters []byte
+	for {
+		sequenceByte, err := reader.ReadByte()
+		if err != nil {
+			return keyPress{}, err
+		}
+		if sequenceByte >= '@' && sequenceByte <= '~' {
+			return keyPress{key: escapeSequenceKey(string(parameters), sequenceByte)}, nil
+		}
+		parameters = append(parameters, sequenceByte)
+	}
+}
+
+// escapeSequenceKey maps the parameters and final byte of an escape sequence to a key:
+func escapeSequenceKey(parameters string, finalByte byte) key {
+	switch finalByte {
+	case 'A':
+		return keyUp
+	case 'B':
+		return keyDown
+	case 'C':
+		return keyRight
+	case 'D':
+		return keyLeft
+	case 'F':
+		return keyEnd
+	case 'H':
+		return keyHome
+	case '~':
+		switch parameters {
+		case "1", "7":
+			return keyHome
+		case "4", "8":
+			return keyEnd
+		case "5":
+			return keyPageUp
+		case "6":
+			return keyPageDown
+		}
+	}
+
+	return keyEscape
+}
@@ -0,0 +1,14 @@
+//go:build !darwin && !linux
+// +build !darwin,!linux
+
+package tableprinter
+
+// makeRaw isn't supported on this platform:
+func makeRaw(fd uintptr) (func() error, error) {
+	return nil, ErrNoTerminal
+}
+
+// terminalSize isn't supported on this platform:
+func terminalSize(fd uintptr) (int, int, error) {
+	return 0, 0, ErrNoTerminal
+}
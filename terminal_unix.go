@@ -0,0 +1,57 @@
+//go:build darwin || linux
+// +build darwin linux
+
+package tableprinter
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// makeRaw puts a terminal into raw mode (without cgo), returning a function which restores its previous state:
+func makeRaw(fd uintptr) (func() error, error) {
+	var previousState syscall.Termios
+	if err := ioctl(fd, ioctlGetTermios, uintptr(unsafe.Pointer(&previousState))); err != nil {
+		return nil, ErrNoTerminal
+	}
+
+	// Disable echo, line buffering, signals and output processing:
+	rawState := previousState
+	rawState.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	rawState.Oflag &^= syscall.OPOST
+	rawState.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	rawState.Cflag &^= syscall.CSIZE | syscall.PARENB
+	rawState.Cflag |= syscall.CS8
+	rawState.Cc[syscall.VMIN] = 1
+	rawState.Cc[syscall.VTIME] = 0
+
+	if err := ioctl(fd, ioctlSetTermios, uintptr(unsafe.Pointer(&rawState))); err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		return ioctl(fd, ioctlSetTermios, uintptr(unsafe.Pointer(&previousState)))
+	}, nil
+}
+
+// terminalSize asks a terminal for its dimensions:
+func terminalSize(fd uintptr) (int, int, error) {
+	var windowSize struct {
+		rows    uint16
+		columns uint16
+		xPixels uint16
+		yPixels uint16
+	}
+	if err := ioctl(fd, syscall.TIOCGWINSZ, uintptr(unsafe.Pointer(&windowSize))); err != nil {
+		return 0, 0, ErrNoTerminal
+	}
+	return int(windowSize.columns), int(windowSize.rows), nil
+}
+
+// ioctl makes an ioctl system call:
+func ioctl(fd, request, argument uintptr) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, request, argument); errno != 0 {
+		return errno
+	}
+	return nil
+}
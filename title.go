@@ -0,0 +1,51 @@
+package tableprinter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// summaryTimeLayout is how the time a table was generated is shown in its summary:
+	summaryTimeLayout = "2006-01-02 15:04:05 MST"
+)
+
+// addTitle centres the title above a rendered table (within the width of the table):
+func (p *Printer) addTitle(renderedTable []byte) []byte {
+	var titleLines []string
+	tableWidth := p.renderedWidth(renderedTable)
+
+	for _, line := range strings.Split(p.title, "\n") {
+		if gap := tableWidth - displayWidth(line, p.eastAsianWidth); gap > 0 {
+			line = strings.Repeat(" ", gap/2) + line
+		}
+		titleLines = append(titleLines, line+"\n")
+	}
+
+	return append([]byte(strings.Join(titleLines, "")), renderedTable...)
+}
+
+// addCaption adds the caption under a rendered table (wrapped to the width of the table):
+func (p *Printer) addCaption(renderedTable []byte) []byte {
+	caption := wrapText(p.caption, p.renderedWidth(renderedTable), WrapWord, p.eastAsianWidth)
+	return append(renderedTable, caption+"\n"...)
+}
+
+// renderedWidth is the width of a rendered table (every line is as wide as the first one):
+func (p *Printer) renderedWidth(renderedTable []byte) int {
+	firstLine := strings.SplitN(string(renderedTable), "\n", 2)[0]
+	return displayWidth(firstLine, p.eastAsianWidth)
+}
+
+// summary says how many rows a table has, and when it was generated:
+func (t *Table) summary(generated time.Time) string {
+	rows := len(t.rows)
+	if t.totalRows > 0 {
+		rows = t.totalRows
+	}
+	if rows == 1 {
+		return fmt.Sprintf("1 row, generated %s\n", generated.Format(summaryTimeLayout))
+	}
+	return fmt.Sprintf("%s rows, generated %s\n", formatCount(rows), generated.Format(summaryTimeLayout))
+}
@@ -0,0 +1,34 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+var titleTestRows = []struct {
+	Name   string
+	Weight int
+}{
+	{"cruft-1", 10},
+	{"cruft-2", 20},
+	{"cruft-3", 30},
+}
+
+func TestTitleAndCaption(t *testing.T) {
+	tablePrinter := tableprinter.New().WithBorders(true).WithTitle("Cruft").WithCaption("Weights are measured in grams, after the cruft has been dried")
+
+	marshaledBytes, err := tablePrinter.Marshal(titleTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "       Cruft\n+---------+--------+\n|  NAME   | WEIGHT |\n+---------+--------+\n| cruft-1 |     10 |\n| cruft-2 |     20 |\n| cruft-3 |     30 |\n+---------+--------+\nWeights are measured\nin grams, after the\ncruft has been dried\n", string(marshaledBytes))
+}
+
+func TestSummary(t *testing.T) {
+	tablePrinter := tableprinter.New().WithSummary(true)
+
+	// The summary counts every row (not just the ones being shown):
+	marshaledBytes, err := tablePrinter.WithLimit(2).Marshal(titleTestRows)
+	assert.NoError(t, err)
+	assert.Regexp(t, `^   NAME   \| WEIGHT  \n(.*\n){3}showing 1–2 of 3 rows\n3 rows, generated \d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2} \S+\n$`, string(marshaledBytes))
+}
@@ -0,0 +1,76 @@
+package tableprinter
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// transposedFieldHeader heads the column of field names in a transposed table:
+	transposedFieldHeader = "field"
+
+	// transposedFooterHeader heads the column which a footer becomes in a transposed table:
+	transposedFooterHeader = "footer"
+)
+
+// transposeTable swaps the rows and columns of a table, so each field is listed down the left (with a column per row).
+// Columns are headed by the values of the key columns (which are then left out), or the row index if there aren't any.
+// A footer becomes the last column:
+func (p *Printer) transposeTable(table *Table) (*Table, error) {
+	var transposedTable = &Table{headers: []string{transposedFieldHeader}}
+
+	// Key columns have to exist (if there are any rows for them to head):
+	for _, keyColumn := range p.keyColumns {
+		if !table.hasHeader(keyColumn) && len(table.rows) > 0 {
+			return nil, ErrNoSuchCell
+		}
+	}
+
+	// Each row becomes a column:
+	var columnHeaders []string
+	var usedHeaders = map[string]bool{transposedFieldHeader: true}
+	for rowIndex, row := range table.rows {
+		columnHeader := strconv.Itoa(rowIndex)
+		if len(p.keyColumns) > 0 {
+			columnHeader = strings.Replace(rowKey(row, rowIndex, p.keyColumns), "\x00", " ", -1)
+		}
+
+		// Headers need to be unique (duplicate keys get their row index too):
+		if usedHeaders[columnHeader] {
+			columnHeader += " (" + strconv.Itoa(rowIndex) + ")"
+		}
+		usedHeaders[columnHeader] = true
+
+		columnHeaders = append(columnHeaders, columnHeader)
+		transposedTable.addHeader(columnHeader)
+	}
+
+	// So does the footer:
+	footerHeader := transposedTable.uniqueHeader(transposedFooterHeader)
+	if table.footer != nil {
+		transposedTable.addHeader(footerHeader)
+	}
+
+	// Each column (apart from the keys) becomes a row:
+	var keyColumns = make(map[string]bool)
+	for _, keyColumn := range p.keyColumns {
+		keyColumns[keyColumn] = true
+	}
+	for _, header := range table.headers {
+		if keyColumns[header] {
+			continue
+		}
+		transposedRow := tableRow{transposedFieldHeader: textCell(header)}
+		for rowIndex, row := range table.rows {
+			if value, ok := row[header]; ok {
+				transposedRow.setField(columnHeaders[rowIndex], value)
+			}
+		}
+		if value, ok := table.footer[header]; ok {
+			transposedRow.setField(footerHeader, value)
+		}
+		transposedTable.addRow(transposedRow)
+	}
+
+	return transposedTable, nil
+}
@@ -0,0 +1,41 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+var transposeTestRows = []struct {
+	Name   string
+	Weight int
+	Crufty bool
+}{
+	{"cruft-1", 5, true},
+	{"cruft-2", 12, false},
+	{"cruft-1", 7, false},
+}
+
+func TestTranspose(t *testing.T) {
+	tablePrinter := tableprinter.New().WithTranspose(true)
+
+	// Columns are headed by row index:
+	marshaledBytes, err := tablePrinter.Marshal(transposeTestRows[:2])
+	assert.NoError(t, err)
+	assert.Equal(t, "  FIELD  |    0    |    1     \n+--------+---------+---------+\n  Crufty | true    | false    \n  Name   | cruft-1 | cruft-2  \n  Weight |       5 |      12  \n", string(marshaledBytes))
+
+	// Or by the values of the key columns (duplicates get their index too):
+	marshaledBytes, err = tablePrinter.WithKeyColumns("Name").Marshal(transposeTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  FIELD  | CRUFT-1 | CRUFT-2 | CRUFT-1 (2)  \n+--------+---------+---------+-------------+\n  Crufty | true    | false   | false        \n  Weight |       5 |      12 |           7  \n", string(marshaledBytes))
+
+	// A footer becomes the last column:
+	marshaledBytes, err = tablePrinter.WithFooter(map[string]tableprinter.Aggregate{"Weight": tableprinter.Sum}).Marshal(transposeTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  FIELD  |    0    |    1    |    2    | FOOTER  \n+--------+---------+---------+---------+--------+\n  Crufty | true    | false   | false   |         \n  Name   | cruft-1 | cruft-2 | cruft-1 |         \n  Weight |       5 |      12 |       7 |     24  \n", string(marshaledBytes))
+
+	// Key columns have to exist:
+	_, err = tablePrinter.WithKeyColumns("Nope").Marshal(transposeTestRows)
+	assert.Equal(t, tableprinter.ErrNoSuchCell, err)
+}
@@ -0,0 +1,176 @@
+package tableprinter
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	treeBranch     = "├─ "
+	treeLastBranch = "└─ "
+	treeLine       = "│  "
+	treeSpace      = "   "
+)
+
+// treeNode is a row of a tree table, and the guides which show where it is in the hierarchy:
+type treeNode struct {
+	guides string
+	row    tableRow
+}
+
+// PrintTree marshals a recursive structure as a tree table and prints it to the configured output:
+func (p *Printer) PrintTree(value interface{}, childrenField string) error {
+
+	// Marshal the value to bytes:
+	marshaledBytes, err := p.MarshalTree(value, childrenField)
+	if err != nil {
+		return err
+	}
+
+	// Now print the marshaled bytes:
+	if _, err := fmt.Fprint(p.output, string(marshaledBytes)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalTree turns a recursive structure (eg a struct with "Children []*Node", or a slice of them) into a tree table.
+// Each node is a row, and the first column (or the first key column) shows the hierarchy with "├─" / "└─" guides:
+func (p *Printer) MarshalTree(value interface{}, childrenField string) ([]byte, error) {
+	table, err := p.treeTable(value, childrenField)
+	if err != nil {
+		return nil, err
+	}
+
+	// The guides only line up if the tree column is left-aligned (even if it holds numbers):
+	treeColumnConfig := p.columnConfig(table.headers[0])
+	treeColumnConfig.Align = AlignLeft
+	return p.WithColumnConfig(table.headers[0], treeColumnConfig).Render(table)
+}
+
+// childrenHeader finds the column which holds the children of a node (they may have been named by a JSON tag):
+func childrenHeader(node reflect.Value, childrenField string) string {
+	node = indirect(node)
+	if node.Kind() != reflect.Struct {
+		return childrenField
+	}
+	for i := 0; i < node.NumField(); i++ {
+		field := node.Type().Field(i)
+		if jsonName := strings.Split(field.Tag.Get("json"), ",")[0]; jsonName == childrenField {
+			return field.Name
+		}
+	}
+	return childrenField
+}
+
+// treeNodes returns the elements of a slice of nodes, leaving out any nil ones (there's nothing to show for them):
+func treeNodes(value reflect.Value) []reflect.Value {
+	var nodes []reflect.Value
+	elements, _ := pathElements(value)
+	for _, element := range elements {
+		if indirect(element).IsValid() {
+			nodes = append(nodes, element)
+		}
+	}
+	return nodes
+}
+
+// treeTable walks a recursive structure (depth-first), turning each node into a row:
+func (p *Printer) treeTable(value interface{}, childrenField string) (*Table, error) {
+	var table = new(Table)
+	var nodes []treeNode
+
+	// Select the part of the value we've been asked to print:
+	value, err := p.selectValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Slices have several roots:
+	roots := treeNodes(reflect.ValueOf(value))
+	if _, ok := pathElements(reflect.ValueOf(value)); !ok || indirect(reflect.ValueOf(value)).Kind() == reflect.Map {
+		roots = []reflect.Value{reflect.ValueOf(value)}
+	}
+
+	// Walk the tree (keeping track of where we've been, in case of loops):
+	var childrenColumn = childrenField
+	var visited = make(map[uintptr]bool)
+	var walk func(node reflect.Value, indent, guide string) error
+	walk = func(node reflect.Value, indent, guide string) error {
+		if node.Kind() == reflect.Ptr && !node.IsNil() {
+			if visited[node.Pointer()] {
+				return nil
+			}
+			visited[node.Pointer()] = true
+		}
+
+		// Turn the node into a row:
+		nodeTable, err := p.makeTable(pathInterface(node))
+		if err != nil {
+			return err
+		}
+		table.adoptHeaders(nodeTable)
+		childrenColumn = childrenHeader(node, childrenField)
+		nodes = append(nodes, treeNode{guides: indent + guide, row: nodeTable.rows[0]})
+
+		// Then do the same for its children:
+		children, ok := lookupField(node, childrenField)
+		if !ok {
+			return ErrPathNotFound
+		}
+		childNodes := treeNodes(children)
+		for childIndex, childNode := range childNodes {
+			childIndent := indent
+			switch guide {
+			case treeBranch:
+				childIndent += treeLine
+			case treeLastBranch:
+				childIndent += treeSpace
+			}
+			childGuide := treeBranch
+			if childIndex == len(childNodes)-1 {
+				childGuide = treeLastBranch
+			}
+			if err := walk(childNode, childIndent, childGuide); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, root := range roots {
+		if err := walk(root, "", ""); err != nil {
+			return nil, err
+		}
+	}
+
+	// The children are shown as rows of their own, so they don't need a column:
+	var headers []string
+	for _, header := range table.headers {
+		if !strings.EqualFold(header, childrenColumn) {
+			headers = append(headers, header)
+		}
+	}
+	table.headers = headers
+	if p.sortedHeaders {
+		sort.Strings(table.headers)
+	}
+	if len(table.headers) == 0 {
+		return nil, ErrNoData
+	}
+
+	// Add the guides to the first column (or the first key column):
+	treeColumn := table.headers[0]
+	if len(p.keyColumns) > 0 && table.hasHeader(p.keyColumns[0]) {
+		treeColumn = p.keyColumns[0]
+		table.moveHeaderToFront(treeColumn)
+	}
+	for _, node := range nodes {
+		node.row.setField(treeColumn, node.row[treeColumn].withText(node.guides+node.row[treeColumn].text))
+		table.addRow(node.row)
+	}
+
+	return table, nil
+}
@@ -0,0 +1,64 @@
+package tableprinter_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+type treeTestNode struct {
+	Name     string
+	Version  string
+	Children []*treeTestNode `json:"deps"`
+}
+
+var treeTestRoot = &treeTestNode{"cruft", "1.0.0", []*treeTestNode{
+	{"cruft-core", "1.2.0", []*treeTestNode{
+		{"cruft-util", "0.1.0", nil},
+		{"cruft-log", "2.0.1", nil},
+	}},
+	{"cruft-cli", "1.0.0", []*treeTestNode{
+		{"cruft-flags", "0.9.0", nil},
+	}},
+}}
+
+func TestPrintTree(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	tablePrinter := tableprinter.New().WithOutput(outputBuffer)
+
+	// The first column shows the hierarchy:
+	err := tablePrinter.PrintTree(treeTestRoot, "Children")
+	assert.NoError(t, err)
+	assert.Equal(t, "        NAME        | VERSION  \n+-------------------+---------+\n  cruft             | 1.0.0    \n  ├─ cruft-core     | 1.2.0    \n  │  ├─ cruft-util  | 0.1.0    \n  │  └─ cruft-log   | 2.0.1    \n  └─ cruft-cli      | 1.0.0    \n     └─ cruft-flags | 0.9.0    \n", outputBuffer.String())
+
+	// Slices have several roots, and key columns choose which column shows the hierarchy:
+	marshaledBytes, err := tablePrinter.WithKeyColumns("Version").MarshalTree(treeTestRoot.Children, "deps")
+	assert.NoError(t, err)
+	assert.Equal(t, "  VERSION  |    NAME      \n+----------+-------------+\n  1.2.0    | cruft-core   \n  ├─ 0.1.0 | cruft-util   \n  └─ 2.0.1 | cruft-log    \n  1.0.0    | cruft-cli    \n  └─ 0.9.0 | cruft-flags  \n", string(marshaledBytes))
+
+	// The children field has to exist:
+	_, err = tablePrinter.MarshalTree(treeTestRoot, "Parents")
+	assert.Equal(t, tableprinter.ErrPathNotFound, err)
+}
+
+type treeTestNumberedNode struct {
+	ID       int
+	Name     string
+	Children []*treeTestNumberedNode
+}
+
+func TestPrintTreeWithNumbers(t *testing.T) {
+	tablePrinter := tableprinter.New()
+	root := &treeTestNumberedNode{1, "cruft", []*treeTestNumberedNode{
+		{2, "cruft-core", []*treeTestNumberedNode{{4, "cruft-util", nil}}},
+		nil,
+		{3, "cruft-cli", []*treeTestNumberedNode{nil}},
+	}}
+
+	// Numbers in the tree column stay on the left (so the guides line up), and nil children are left out:
+	marshaledBytes, err := tablePrinter.MarshalTree(root, "Children")
+	assert.NoError(t, err)
+	assert.Equal(t, "    ID    |    NAME     \n+---------+------------+\n  1       | cruft       \n  ├─ 2    | cruft-core  \n  │  └─ 4 | cruft-util  \n  └─ 3    | cruft-cli   \n", string(marshaledBytes))
+}
@@ -0,0 +1,428 @@
+package tableprinter
+
+import (
+	"encoding"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	// timeLayout is how time.Time values are rendered (by their String() method):
+	timeLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+)
+
+// Unmarshal parses a rendered table (with or without borders) into a slice of structs or maps (or a single struct or map).
+// Headers are matched to struct fields (or JSON tags) case-insensitively, ignoring spaces and underscores. Footers are left
+// out. Each line is a row, unless the table has row separators: the lines between them are then joined back up (with
+// newlines), and merged cells get the value above them:
+func Unmarshal(data []byte, v interface{}) error {
+
+	// We can only unmarshal into something we can modify:
+	reflectedValue := reflect.ValueOf(v)
+	if reflectedValue.Kind() != reflect.Ptr || reflectedValue.IsNil() {
+		return ErrInvalidTarget
+	}
+
+	// Parse the table into headers and rows of values:
+	headers, rows, err := parseTable(string(data))
+	if err != nil {
+		return err
+	}
+
+	target := reflectedValue.Elem()
+	switch target.Kind() {
+
+	// Slices get one element per row:
+	case reflect.Slice:
+		elements := reflect.MakeSlice(target.Type(), len(rows), len(rows))
+		for rowIndex, row := range rows {
+			if err := setValue(elements.Index(rowIndex), headers, row); err != nil {
+				return err
+			}
+		}
+		target.Set(elements)
+		return nil
+
+	// Anything else just gets the first row:
+	default:
+		return setValue(target, headers, rows[0])
+	}
+}
+
+// parseTable splits a rendered table into its headers and rows:
+func parseTable(data string) ([]string, [][]string, error) {
+	var lines []string
+	var rows [][]string
+
+	// Ignore blank lines (and any trailing space):
+	for _, line := range strings.Split(data, "\n") {
+		if line = strings.TrimRight(line, " \r"); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	// Find the line under the header (it tells us where the columns are). Titles, header groups and top borders also
+	// come before it, but the header is the first line which lines up with the columns of the separator under it:
+	headerSeparator := -1
+	for lineIndex, line := range lines {
+		if lineIndex > 0 && isSeparatorLine(line) && isHeaderLine(lines[lineIndex-1], lines[lineIndex+1:], columnBoundaries(line)) {
+			headerSeparator = lineIndex
+			break
+		}
+	}
+	if headerSeparator < 0 {
+		return nil, nil, ErrNoData
+	}
+	boundaries := columnBoundaries(lines[headerSeparator])
+
+	// The header is just above the separator:
+	headers := splitColumns(lines[headerSeparator-1], boundaries)
+
+	// Everything below the separator is part of the table, up to the first line which isn't (apart from the bottom border
+	// and any footer):
+	var tableLines []string
+	for _, line := range lines[headerSeparator+1:] {
+		if !isTableLine(line) {
+			break
+		}
+		tableLines = append(tableLines, line)
+	}
+	tableLines = withoutFooter(tableLines, strings.HasPrefix(lines[headerSeparator-1], "|"))
+
+	// Each line is a row, unless there are separators between the rows (which show where rows take up several lines):
+	if hasRowSeparators(tableLines) {
+		rows = separatedRows(tableLines, boundaries)
+	} else {
+		for _, line := range tableLines {
+			rows = append(rows, splitColumns(line, boundaries))
+		}
+	}
+	if len(rows) == 0 {
+		return nil, nil, ErrNoData
+	}
+
+	return headers, rows, nil
+}
+
+// isSeparatorLine determines whether a line is a border (eg "+------+-----+", or "+======+=====+" above a footer):
+func isSeparatorLine(line string) bool {
+	line = strings.TrimSpace(line)
+	return strings.HasPrefix(line, "+") && strings.Trim(line, "+-=") == ""
+}
+
+// isRowSeparatorLine determines whether a line separates two rows (it may be left open across merged cells, eg
+// "|      +-----+"):
+func isRowSeparatorLine(line string) bool {
+	line = ansiEscapeSequence.ReplaceAllString(line, "")
+	return isSeparatorLine(line) || strings.Trim(line, "|+- ") == "" && strings.Contains(line, "+-")
+}
+
+// isHeaderLine determines whether a line is the header above a separator: it starts with the same edge as the lines of
+// the table under the separator, and has a column separator ("|") between each of the columns:
+func isHeaderLine(line string, linesBelow []string, boundaries []int) bool {
+	line = ansiEscapeSequence.ReplaceAllString(line, "")
+	if line == "" || len(boundaries) < 2 {
+		return false
+	}
+	for _, lineBelow := range linesBelow {
+		if lineBelow = ansiEscapeSequence.ReplaceAllString(lineBelow, ""); !isSeparatorLine(lineBelow) {
+			if line[0] != lineBelow[0] {
+				return false
+			}
+			break
+		}
+	}
+
+	// Find what is at each display column:
+	var lineGraphemes = make(map[int]string)
+	var displayColumn int
+	graphemes(line, runewidth.EastAsianWidth, func(grapheme string, width int) {
+		lineGraphemes[displayColumn] = grapheme
+		displayColumn += width
+	})
+	for _, boundary := range boundaries[1 : len(boundaries)-1] {
+		if lineGraphemes[boundary] != "|" {
+			return false
+		}
+	}
+	return true
+}
+
+// isTableLine determines whether a line is part of a table (lines which don't start with an edge, eg the "showing 1–50 of
+// 12,304 rows" notice under a windowed table, come after it):
+func isTableLine(line string) bool {
+	return strings.IndexAny(ansiEscapeSequence.ReplaceAllString(line, ""), " |+") == 0
+}
+
+// withoutFooter drops the bottom border and any footer from the end of the lines of a table. Without borders, footers
+// come after the only separator below the rows. With borders, they come after a double line ("+======+"):
+func withoutFooter(lines []string, borders bool) []string {
+	if borders {
+		for lineIndex, line := range lines {
+			if strings.HasPrefix(strings.TrimSpace(line), "+=") {
+				return lines[:lineIndex]
+			}
+		}
+		if len(lines) > 0 && isSeparatorLine(lines[len(lines)-1]) {
+			return lines[:len(lines)-1]
+		}
+		return lines
+	}
+
+	if len(lines) == 0 || !isSeparatorLine(lines[len(lines)-1]) {
+		return lines
+	}
+	for lineIndex := len(lines) - 2; lineIndex >= 0; lineIndex-- {
+		if isSeparatorLine(lines[lineIndex]) {
+			return lines[:lineIndex]
+		}
+	}
+	return lines
+}
+
+// hasRowSeparators determines whether there are separators between the rows of a table:
+func hasRowSeparators(lines []string) bool {
+	for _, line := range lines {
+		if isRowSeparatorLine(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// separatedRows turns the lines of a table with row separators into rows. The lines between two separators make up one
+// row (the lines of each value are joined back up with newlines), and cells which the separator above leaves open are
+// merged with the row above (so they have its value):
+func separatedRows(lines []string, boundaries []int) [][]string {
+	var rows [][]string
+	var rowLines [][]string
+	var mergedColumns = make([]bool, len(boundaries)-1)
+
+	addRow := func() {
+		if len(rowLines) == 0 {
+			return
+		}
+		row := make([]string, len(boundaries)-1)
+		for columnIndex := range row {
+			if mergedColumns[columnIndex] && len(rows) > 0 {
+				row[columnIndex] = rows[len(rows)-1][columnIndex]
+				continue
+			}
+			var valueLines []string
+			for _, lineValues := range rowLines {
+				valueLines = append(valueLines, lineValues[columnIndex])
+			}
+			row[columnIndex] = strings.TrimRight(strings.Join(valueLines, "\n"), "\n")
+		}
+		rows = append(rows, row)
+		rowLines = nil
+	}
+
+	for _, line := range lines {
+		if !isRowSeparatorLine(line) {
+			rowLines = append(rowLines, splitColumns(line, boundaries))
+			continue
+		}
+		addRow()
+		for columnIndex, value := range cutColumns(ansiEscapeSequence.ReplaceAllString(line, ""), boundaries) {
+			mergedColumns[columnIndex] = strings.TrimSpace(value) == ""
+		}
+	}
+	addRow()
+
+	return rows
+}
+
+// columnBoundaries finds the positions of the column separators in a border line:
+func columnBoundaries(separatorLine string) []int {
+	var boundaries []int
+	for position, char := range separatorLine {
+		if char == '+' {
+			boundaries = append(boundaries, position)
+		}
+	}
+	return boundaries
+}
+
+// splitColumns cuts a line into trimmed values using the column boundaries:
+func splitColumns(line string, boundaries []int) []string {
+	values := cutColumns(line, boundaries)
+	for columnIndex := range values {
+		values[columnIndex] = strings.TrimSpace(values[columnIndex])
+	}
+	return values
+}
+
+// cutColumns cuts a line into the text between the column boundaries (measured in display columns, ignoring colours):
+func cutColumns(line string, boundaries []int) []string {
+	var values = make([]string, len(boundaries)-1)
+	var displayColumn, columnIndex int
+
+	graphemes(ansiEscapeSequence.ReplaceAllString(line, ""), runewidth.EastAsianWidth, func(grapheme string, width int) {
+		for columnIndex < len(boundaries)-1 && displayColumn >= boundaries[columnIndex+1] {
+			columnIndex++
+		}
+
+		// Skip the separators themselves:
+		if columnIndex < len(values) && displayColumn != boundaries[columnIndex] {
+			values[columnIndex] += grapheme
+		}
+		displayColumn += width
+	})
+
+	return values
+}
+
+// normaliseHeader makes headers and field names comparable (the renderer upper-cases headers and replaces "_" and "." with spaces):
+func normaliseHeader(header string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "_", "", ".", "", "-", "").Replace(header))
+}
+
+// setValue fills in a struct or a map (or a pointer to one) from a row of values:
+func setValue(target reflect.Value, headers, row []string) error {
+
+	// Allocate pointers as we go:
+	if target.Kind() == reflect.Ptr {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		return setValue(target.Elem(), headers, row)
+	}
+
+	switch target.Kind() {
+
+	// Maps are keyed by header:
+	case reflect.Map:
+		if target.Type().Key().Kind() != reflect.String {
+			return ErrInvalidTarget
+		}
+		if target.IsNil() {
+			target.Set(reflect.MakeMap(target.Type()))
+		}
+		for columnIndex, header := range headers {
+			mapValue := reflect.New(target.Type().Elem()).Elem()
+			if err := setField(mapValue, row[columnIndex]); err != nil {
+				return err
+			}
+			target.SetMapIndex(reflect.ValueOf(header).Convert(target.Type().Key()), mapValue)
+		}
+		return nil
+
+	// Struct fields are matched to headers (at least one of them has to match):
+	case reflect.Struct:
+		var matchedFields int
+		fieldIndexes := structFieldIndexes(target.Type())
+		for columnIndex, header := range headers {
+			fieldIndex, ok := fieldIndexes[normaliseHeader(header)]
+			if !ok {
+				continue
+			}
+			if err := setField(target.Field(fieldIndex), row[columnIndex]); err != nil {
+				return err
+			}
+			matchedFields++
+		}
+		if matchedFields == 0 {
+			return ErrNoMatchingFields
+		}
+		return nil
+	}
+
+	return ErrInvalidTarget
+}
+
+// structFieldIndexes maps the normalised names (and JSON tags) of exported struct fields to their indexes:
+func structFieldIndexes(structType reflect.Type) map[string]int {
+	var fieldIndexes = make(map[string]int)
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		if jsonName := strings.Split(field.Tag.Get("json"), ",")[0]; jsonName != "" && jsonName != "-" {
+			fieldIndexes[normaliseHeader(jsonName)] = i
+		}
+		fieldIndexes[normaliseHeader(field.Name)] = i
+	}
+
+	return fieldIndexes
+}
+
+// setField parses a value into a field (fields which can't be represented as text are left alone):
+func setField(field reflect.Value, value string) error {
+
+	// Nil (and empty) values are left as they are:
+	if value == nilFieldValue || (value == "" && field.Kind() != reflect.String) {
+		return nil
+	}
+
+	// Allocate pointers as we go:
+	if field.Kind() == reflect.Ptr {
+		fieldValue := reflect.New(field.Type().Elem())
+		if err := setField(fieldValue.Elem(), value); err != nil {
+			return err
+		}
+		field.Set(fieldValue)
+		return nil
+	}
+
+	// Anything which knows how to parse itself gets to do so:
+	if textUnmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok && field.Type() != reflect.TypeOf(time.Time{}) {
+		return textUnmarshaler.UnmarshalText([]byte(value))
+	}
+
+	switch field.Kind() {
+
+	case reflect.Bool:
+		parsedValue, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(parsedValue)
+
+	case reflect.Float32, reflect.Float64:
+		parsedValue, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(parsedValue)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsedValue, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(parsedValue)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsedValue, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(parsedValue)
+
+	case reflect.Interface:
+		if field.NumMethod() == 0 {
+			field.Set(reflect.ValueOf(value))
+		}
+
+	case reflect.String:
+		field.SetString(value)
+
+	case reflect.Struct:
+		if field.Type() == reflect.TypeOf(time.Time{}) {
+			parsedValue, err := time.Parse(timeLayout, value)
+			if err != nil {
+				return err
+			}
+			field.Set(reflect.ValueOf(parsedValue))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package tableprinter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+type unmarshalCruft struct {
+	Name           string
+	Weight         int
+	Cruftiness     float64
+	Crufty         *bool
+	Started        time.Time
+	FavouriteWords string `json:"favourite_words"`
+}
+
+func TestUnmarshalRoundTrip(t *testing.T) {
+	crufty := true
+	crufts := []unmarshalCruft{
+		{Name: "cruft-1", Weight: 1000, Cruftiness: 99.5, Crufty: &crufty, Started: testTime.UTC(), FavouriteWords: "cruft crufts"},
+		{Name: "クラフト", Weight: -5, Started: testTime.UTC()},
+	}
+
+	for _, borders := range []bool{false, true} {
+		tableBytes, err := tableprinter.New().WithBorders(borders).Marshal(crufts)
+		assert.NoError(t, err)
+
+		// Parse the table back into structs:
+		var unmarshaledCrufts []unmarshalCruft
+		err = tableprinter.Unmarshal(tableBytes, &unmarshaledCrufts)
+		assert.NoError(t, err)
+		assert.Equal(t, crufts, unmarshaledCrufts)
+
+		// The notice under a windowed table isn't a row:
+		tableBytes, err = tableprinter.New().WithBorders(borders).WithLimit(1).Marshal(crufts)
+		assert.NoError(t, err)
+		err = tableprinter.Unmarshal(tableBytes, &unmarshaledCrufts)
+		assert.NoError(t, err)
+		assert.Equal(t, crufts[:1], unmarshaledCrufts)
+	}
+}
+
+func TestUnmarshalMultiLineRows(t *testing.T) {
+	type multiLineCruft struct {
+		Name        string
+		Weight      int
+		Description string
+	}
+	crufts := []multiLineCruft{
+		{Name: "alice", Weight: 1, Description: "hello world"},
+		{Name: "bob", Weight: 2, Description: ""},
+		{Name: "carol", Weight: 0, Description: "not\ncrufty"},
+	}
+
+	// Wrapped values come back with a line for each line of the cell:
+	unmarshaledMultiLineCrufts := []multiLineCruft{
+		{Name: "alice", Weight: 1, Description: "hello\nworld"},
+		{Name: "bob", Weight: 2, Description: ""},
+		{Name: "carol", Weight: 0, Description: "not\ncrufty"},
+	}
+
+	for _, borders := range []bool{false, true} {
+		tablePrinter := tableprinter.New().WithBorders(borders).WithRowSeparators(true).
+			WithColumnConfig("Description", tableprinter.ColumnConfig{MaxWidth: 6})
+
+		// Rows between row separators come back as one row:
+		tableBytes, err := tablePrinter.Marshal(crufts)
+		assert.NoError(t, err)
+		var unmarshaledCrufts []multiLineCruft
+		err = tableprinter.Unmarshal(tableBytes, &unmarshaledCrufts)
+		assert.NoError(t, err)
+		assert.Equal(t, unmarshaledMultiLineCrufts, unmarshaledCrufts)
+
+		// And render the same way again:
+		remarshaledBytes, err := tablePrinter.Marshal(unmarshaledCrufts)
+		assert.NoError(t, err)
+		assert.Equal(t, string(tableBytes), string(remarshaledBytes))
+
+		// Footers aren't rows:
+		tableBytes, err = tablePrinter.WithFooter(map[string]tableprinter.Aggregate{"Name": tableprinter.Count, "Weight": tableprinter.Sum, "Description": tableprinter.Min}).Marshal(crufts)
+		assert.NoError(t, err)
+		err = tableprinter.Unmarshal(tableBytes, &unmarshaledCrufts)
+		assert.NoError(t, err)
+		assert.Equal(t, unmarshaledMultiLineCrufts, unmarshaledCrufts)
+	}
+
+	// Without row separators every line is a row:
+	tableBytes, err := tableprinter.New().WithColumnConfig("Description", tableprinter.ColumnConfig{MaxWidth: 6}).Marshal(crufts[:1])
+	assert.NoError(t, err)
+	var unmarshaledCrufts []multiLineCruft
+	err = tableprinter.Unmarshal(tableBytes, &unmarshaledCrufts)
+	assert.NoError(t, err)
+	assert.Equal(t, []multiLineCruft{{Name: "alice", Weight: 1, Description: "hello"}, {Description: "world"}}, unmarshaledCrufts)
+}
+
+func TestUnmarshalEmptyCells(t *testing.T) {
+	type emptyCruft struct {
+		Name     string
+		Nickname string
+	}
+	crufts := []emptyCruft{{"a", "x"}, {"b", ""}, {"c", "y"}}
+
+	// Rows with empty cells are still rows of their own:
+	for _, borders := range []bool{false, true} {
+		for _, rowSeparators := range []bool{false, true} {
+			tableBytes, err := tableprinter.New().WithBorders(borders).WithRowSeparators(rowSeparators).Marshal(crufts)
+			assert.NoError(t, err)
+			var unmarshaledCrufts []emptyCruft
+			err = tableprinter.Unmarshal(tableBytes, &unmarshaledCrufts)
+			assert.NoError(t, err)
+			assert.Equal(t, crufts, unmarshaledCrufts)
+		}
+	}
+}
+
+func TestUnmarshalMergedCells(t *testing.T) {
+	type mergedCruft struct {
+		Country  string
+		Customer string
+	}
+	crufts := []mergedCruft{{"eu", "cruft-1"}, {"eu", "cruft-2"}, {"us", "cruft-3"}}
+
+	for _, borders := range []bool{false, true} {
+		tablePrinter := tableprinter.New().WithBorders(borders).WithMergedCells(true)
+
+		// Row separators are left open across merged cells, so they get the value above them:
+		tableBytes, err := tablePrinter.WithRowSeparators(true).Marshal(crufts)
+		assert.NoError(t, err)
+		var unmarshaledCrufts []mergedCruft
+		err = tableprinter.Unmarshal(tableBytes, &unmarshaledCrufts)
+		assert.NoError(t, err)
+		assert.Equal(t, crufts, unmarshaledCrufts)
+
+		// Without row separators they can't be told apart from empty cells (but the rows are all still there):
+		tableBytes, err = tablePrinter.Marshal(crufts)
+		assert.NoError(t, err)
+		err = tableprinter.Unmarshal(tableBytes, &unmarshaledCrufts)
+		assert.NoError(t, err)
+		assert.Equal(t, []mergedCruft{{"eu", "cruft-1"}, {"", "cruft-2"}, {"us", "cruft-3"}}, unmarshaledCrufts)
+	}
+}
+
+func TestUnmarshalFooters(t *testing.T) {
+	type numericCruft struct {
+		Weight int
+		Height int
+	}
+	crufts := []numericCruft{{1, 2}, {3, 4}, {5, 6}}
+
+	// Footers are found by the separator above them, so numeric rows are never mistaken for one:
+	for _, borders := range []bool{false, true} {
+		for _, rowSeparators := range []bool{false, true} {
+			tablePrinter := tableprinter.New().WithBorders(borders).WithRowSeparators(rowSeparators)
+
+			tableBytes, err := tablePrinter.Marshal(crufts)
+			assert.NoError(t, err)
+			var unmarshaledCrufts []numericCruft
+			err = tableprinter.Unmarshal(tableBytes, &unmarshaledCrufts)
+			assert.NoError(t, err)
+			assert.Equal(t, crufts, unmarshaledCrufts)
+
+			tableBytes, err = tablePrinter.WithFooter(map[string]tableprinter.Aggregate{"Weight": tableprinter.Sum}).Marshal(crufts)
+			assert.NoError(t, err)
+			err = tableprinter.Unmarshal(tableBytes, &unmarshaledCrufts)
+			assert.NoError(t, err)
+			assert.Equal(t, crufts, unmarshaledCrufts)
+		}
+	}
+}
+
+func TestUnmarshalHeaders(t *testing.T) {
+	crufts := []unmarshalCruft{{Name: "cruft-1", Weight: 1000, Started: testTime.UTC()}}
+
+	// Titles go above the headers:
+	for _, borders := range []bool{false, true} {
+		tableBytes, err := tableprinter.New().WithBorders(borders).WithTitle("Crufts | Weights").Marshal(crufts)
+		assert.NoError(t, err)
+		var unmarshaledCrufts []unmarshalCruft
+		err = tableprinter.Unmarshal(tableBytes, &unmarshaledCrufts)
+		assert.NoError(t, err)
+		assert.Equal(t, crufts, unmarshaledCrufts)
+	}
+
+	// So do header groups:
+	type groupedCruft struct {
+		Name   string
+		Limits struct{ CPU, Memory int }
+	}
+	for _, borders := range []bool{false, true} {
+		tableBytes, err := tableprinter.New().WithBorders(borders).WithFlattenedStructs(true).Marshal([]groupedCruft{{Name: "cruft-1"}})
+		assert.NoError(t, err)
+		var unmarshaledMaps []map[string]string
+		err = tableprinter.Unmarshal(tableBytes, &unmarshaledMaps)
+		assert.NoError(t, err)
+		assert.Equal(t, []map[string]string{{"NAME": "cruft-1", "CPU": "0", "MEMORY": "0"}}, unmarshaledMaps)
+	}
+
+	// Headers have to match some of the fields:
+	tableBytes, err := tableprinter.New().Marshal(crufts)
+	assert.NoError(t, err)
+	var ages []struct{ Age int }
+	assert.Equal(t, tableprinter.ErrNoMatchingFields, tableprinter.Unmarshal(tableBytes, &ages))
+}
+
+func TestUnmarshalMaps(t *testing.T) {
+	tableBytes := []byte("  AGE  | CRUFTY |   NAME     \n+------+--------+-----------+\n  7654 | true   | prawn_map  \n")
+
+	// Slice of maps:
+	var unmarshaledMaps []map[string]interface{}
+	err := tableprinter.Unmarshal(tableBytes, &unmarshaledMaps)
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{"AGE": "7654", "CRUFTY": "true", "NAME": "prawn_map"}}, unmarshaledMaps)
+
+	// Single map:
+	var unmarshaledMap map[string]string
+	err = tableprinter.Unmarshal(tableBytes, &unmarshaledMap)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"AGE": "7654", "CRUFTY": "true", "NAME": "prawn_map"}, unmarshaledMap)
+
+	// Single struct (via a pointer):
+	var unmarshaledStruct *struct {
+		Age  int
+		Name string
+	}
+	err = tableprinter.Unmarshal(tableBytes, &unmarshaledStruct)
+	assert.NoError(t, err)
+	assert.Equal(t, 7654, unmarshaledStruct.Age)
+	assert.Equal(t, "prawn_map", unmarshaledStruct.Name)
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	tableBytes := []byte("  AGE   \n+-------+\n  cruft  \n")
+
+	// Not a pointer:
+	var crufts []unmarshalCruft
+	assert.Equal(t, tableprinter.ErrInvalidTarget, tableprinter.Unmarshal(tableBytes, crufts))
+
+	// Not a table:
+	assert.Equal(t, tableprinter.ErrNoData, tableprinter.Unmarshal([]byte("cruft\n"), &crufts))
+
+	// Not a number:
+	var ages []struct{ Age int }
+	assert.Error(t, tableprinter.Unmarshal(tableBytes, &ages))
+}
@@ -0,0 +1,104 @@
+package tableprinter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+)
+
+// Watch evaluates a source on an interval and redraws its table in place, highlighting anything which has changed:
+func (p *Printer) Watch(ctx context.Context, interval time.Duration, source func() (interface{}, error)) error {
+	var previousLines int
+	var previousTable *Table
+
+	if interval <= 0 {
+		return ErrInvalidInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+
+		// Get the latest value from the source:
+		value, err := source()
+		if err != nil {
+			return err
+		}
+
+		// Turn the value into a table:
+		currentTable, err := p.tableFromValue(value)
+		if err != nil {
+			return err
+		}
+
+		// Render the table with any changes highlighted (an empty table is still worth drawing over the last one):
+		highlightedTable, err := highlightChanges(previousTable, currentTable, p.keyColumns)
+		if err != nil {
+			return err
+		}
+		tableBytes, err := p.wrapCells(highlightedTable).bytes(p)
+		if err != nil && err != ErrNoData {
+			return err
+		}
+
+		// Move back up over the previous frame, clear it, then draw the new one (all in one write to avoid flickering):
+		frame := bytes.NewBuffer(nil)
+		if previousLines > 0 {
+			fmt.Fprintf(frame, ansiCursorUp+"\r"+ansiClearDown, previousLines)
+		}
+		frame.Write(tableBytes)
+		if _, err := frame.WriteTo(p.output); err != nil {
+			return err
+		}
+
+		previousLines = bytes.Count(tableBytes, []byte("\n"))
+		previousTable = currentTable
+
+		// Wait for the next tick (or for the context to be cancelled):
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// highlightChanges makes a table showing the changes since the previous one (added rows, removed rows and changed cells):
+func highlightChanges(previousTable, currentTable *Table, keyColumns []string) (*Table, error) {
+	if previousTable == nil {
+		return currentTable, nil
+	}
+
+	// Removed rows still need headers, even if the current table is empty:
+	var highlightedTable = &Table{headers: currentTable.headers}
+	if len(highlightedTable.headers) == 0 {
+		highlightedTable.headers = previousTable.headers
+	}
+
+	rowDiffs, err := diffTables(previousTable, currentTable, keyColumns)
+	if err != nil {
+		return nil, err
+	}
+	for _, rowDiff := range rowDiffs {
+		var row = make(tableRow)
+
+		for _, header := range highlightedTable.headers {
+			switch {
+			case rowDiff.change == rowAdded:
+				row[header] = rowDiff.newRow[header].withText(colourise(ansiGreen, rowDiff.newRow[header].text))
+			case rowDiff.change == rowRemoved:
+				row[header] = rowDiff.oldRow[header].withText(colourise(ansiRed, rowDiff.oldRow[header].text))
+			case rowDiff.changedFields[header]:
+				row[header] = rowDiff.newRow[header].withText(colourise(ansiYellow, rowDiff.newRow[header].text))
+			default:
+				row[header] = rowDiff.newRow[header]
+			}
+		}
+
+		highlightedTable.addRow(row)
+	}
+
+	return highlightedTable, nil
+}
@@ -0,0 +1,63 @@
+package tableprinter_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+type watchedCruft struct {
+	Name   string
+	Weight int
+}
+
+func TestWatch(t *testing.T) {
+	outputBuffer := bytes.NewBufferString("")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Each evaluation of the source returns the next set of crufts:
+	sources := [][]watchedCruft{
+		{{"cruft-1", 1}, {"cruft-2", 2}, {"cruft-3", 3}},
+		{{"cruft-1", 1}, {"cruft-3", 33}, {"cruft-4", 4}},
+		{},
+	}
+	source := func() (interface{}, error) {
+		value := sources[0]
+		if sources = sources[1:]; len(sources) == 0 {
+			cancel()
+		}
+		return value, nil
+	}
+
+	// Watch until the source runs out:
+	err := tableprinter.New().WithOutput(outputBuffer).WithKeyColumns("Name").Watch(ctx, time.Millisecond, source)
+	assert.Equal(t, context.Canceled, err)
+
+	// First frame is plain:
+	expectedOutput := "   NAME   | WEIGHT  \n+---------+--------+\n  cruft-1 |      1  \n  cruft-2 |      2  \n  cruft-3 |      3  \n"
+
+	// Second frame redraws over the first, showing the removed, changed and added rows:
+	expectedOutput += "\x1b[5A\r\x1b[J" + "   NAME   | WEIGHT  \n+---------+--------+\n  cruft-1 |      1  \n  \x1b[31mcruft-2\x1b[0m |      \x1b[31m2\x1b[0m  \n  cruft-3 |     \x1b[33m33\x1b[0m  \n  \x1b[32mcruft-4\x1b[0m |      \x1b[32m4\x1b[0m  \n"
+
+	// Third frame shows every row being removed:
+	expectedOutput += "\x1b[6A\r\x1b[J" + "   NAME   | WEIGHT  \n+---------+--------+\n  \x1b[31mcruft-1\x1b[0m |      \x1b[31m1\x1b[0m  \n  \x1b[31mcruft-3\x1b[0m |     \x1b[31m33\x1b[0m  \n  \x1b[31mcruft-4\x1b[0m |      \x1b[31m4\x1b[0m  \n"
+
+	assert.Equal(t, expectedOutput, outputBuffer.String())
+}
+
+func TestWatchSourceError(t *testing.T) {
+	source := func() (interface{}, error) {
+		return nil, tableprinter.ErrNoData
+	}
+
+	err := tableprinter.New().Watch(context.Background(), time.Millisecond, source)
+	assert.Equal(t, tableprinter.ErrNoData, err)
+
+	// The interval has to be positive:
+	err = tableprinter.New().Watch(context.Background(), 0, source)
+	assert.Equal(t, tableprinter.ErrInvalidInterval, err)
+}
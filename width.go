@@ -0,0 +1,147 @@
+package tableprinter
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	combiningEnclosingKeycap = '\u20e3'
+	emojiPresentation        = '\ufe0f'
+	textPresentation         = '\ufe0e'
+	zeroWidthJoiner          = '\u200d'
+)
+
+// graphemes splits text into grapheme clusters (the characters a reader would count), calling a function with each one
+// and its width. This covers combining marks, variation selectors, emoji modifiers, zero-width-joiner sequences, flags
+// and Hangul syllables (a practical subset of Unicode's segmentation rules). Ambiguous East Asian characters (eg "·")
+// are two columns wide if eastAsianWidth is set:
+func graphemes(text string, eastAsianWidth bool, handle func(grapheme string, width int)) {
+	condition := &runewidth.Condition{EastAsianWidth: eastAsianWidth}
+
+	for len(text) > 0 {
+		base, size := utf8.DecodeRuneInString(text)
+		width := condition.RuneWidth(base)
+
+		// Extend the cluster for as long as the following runes belong to it:
+		for previous := base; size < len(text); {
+			next, nextSize := utf8.DecodeRuneInString(text[size:])
+			if !extendsGrapheme(base, previous, next, size) {
+				break
+			}
+
+			// Emoji presentation makes the cluster wide, text presentation makes it narrow:
+			switch next {
+			case emojiPresentation:
+				width = 2
+			case textPresentation:
+				width = 1
+			}
+
+			size += nextSize
+			previous = next
+		}
+
+		handle(text[:size], width)
+		text = text[size:]
+	}
+}
+
+// extendsGrapheme determines whether a rune belongs to the grapheme cluster before it (which starts with base, and has
+// size bytes so far):
+func extendsGrapheme(base, previous, next rune, size int) bool {
+	switch {
+
+	// Anything either side of a zero-width joiner is part of the same cluster:
+	case previous == zeroWidthJoiner, next == zeroWidthJoiner:
+		return true
+
+	// Pairs of regional indicators make flags:
+	case isRegionalIndicator(next):
+		return isRegionalIndicator(base) && size == utf8.RuneLen(base)
+
+	// Hangul vowels and final consonants join the syllable before them:
+	case isHangulJamoExtension(next):
+		return isHangul(base)
+
+	// Marks, modifiers and tags don't take up any space of their own:
+	default:
+		return next == combiningEnclosingKeycap || isEmojiModifier(next) || isTag(next) || isVariationSelector(next) ||
+			unicode.In(next, unicode.Mn, unicode.Me)
+	}
+}
+
+// displayWidth measures how many columns of a terminal some text takes up (ignoring colour codes):
+func displayWidth(text string, eastAsianWidth bool) int {
+	var width int
+	graphemes(ansiEscapeSequence.ReplaceAllString(text, ""), eastAsianWidth, func(_ string, graphemeWidth int) {
+		width += graphemeWidth
+	})
+	return width
+}
+
+// cropDisplay cuts text down to a range of terminal columns (keeping any colour codes). Wide characters which would
+// be cut in half are left out:
+func cropDisplay(text string, leftColumn, width int, eastAsianWidth bool) string {
+	var cropped strings.Builder
+	var column int
+
+	for len(text) > 0 {
+
+		// Colour codes don't take up any space (so they are always kept):
+		if location := ansiEscapeSequence.FindStringIndex(text); location != nil && location[0] == 0 {
+			cropped.WriteString(text[:location[1]])
+			text = text[location[1]:]
+			continue
+		}
+
+		// Take the text up to the next colour code:
+		plainText := text
+		if location := ansiEscapeSequence.FindStringIndex(text); location != nil {
+			plainText = text[:location[0]]
+		}
+		text = text[len(plainText):]
+
+		graphemes(plainText, eastAsianWidth, func(grapheme string, graphemeWidth int) {
+			if column >= leftColumn && column+graphemeWidth <= leftColumn+width {
+				cropped.WriteString(grapheme)
+			}
+			column += graphemeWidth
+		})
+	}
+
+	return cropped.String()
+}
+
+// isEmojiModifier determines whether a rune is a skin tone modifier:
+func isEmojiModifier(r rune) bool {
+	return r >= 0x1f3fb && r <= 0x1f3ff
+}
+
+// isHangul determines whether a rune is a Hangul syllable or leading consonant:
+func isHangul(r rune) bool {
+	return (r >= 0x1100 && r <= 0x115f) || (r >= 0xac00 && r <= 0xd7a3)
+}
+
+// isHangulJamoExtension determines whether a rune is a Hangul vowel or final consonant (which join a syllable):
+func isHangulJamoExtension(r rune) bool {
+	return (r >= 0x1160 && r <= 0x11ff) || (r >= 0xd7b0 && r <= 0xd7ff)
+}
+
+// isRegionalIndicator determines whether a rune is half of a flag:
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// isTag determines whether a rune is a tag character (used in subdivision flags):
+func isTag(r rune) bool {
+	return r >= 0xe0020 && r <= 0xe007f
+}
+
+// isVariationSelector determines whether a rune selects a variant of the character before it:
+func isVariationSelector(r rune) bool {
+	return (r >= 0xfe00 && r <= 0xfe0f) || (r >= 0xe0100 && r <= 0xe01ef)
+}
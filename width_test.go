@@ -0,0 +1,72 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+var widthTestRows = []struct {
+	Name  string
+	Owner string
+}{
+	{"cafe\u0301", "ascii"},
+	{"\U0001F468\u200d\U0001F469\u200d\U0001F467", "family"},
+	{"\U0001F44D\U0001F3FD", "thumbs"},
+	{"\u2764\ufe0f", "heart"},
+	{"\U0001F1EC\U0001F1E7", "flag"},
+	{"日本語", "cjk"},
+	{"한", "hangul"},
+	{"\x1b[32mgreen\x1b[0m", "colour"},
+	{"\x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\", "hyperlink"},
+}
+
+func TestMixedScriptWidths(t *testing.T) {
+	marshaledBytes, err := tableprinter.New().WithBorders(true).WithSortedHeaders(false).Marshal(widthTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"+--------+-----------+\n"+
+			"|  NAME  |   OWNER   |\n"+
+			"+--------+-----------+\n"+
+			"| café   | ascii     |\n"+
+			"| 👨‍👩‍👧     | family    |\n"+
+			"| 👍🏽     | thumbs    |\n"+
+			"| ❤️     | heart     |\n"+
+			"| 🇬🇧     | flag      |\n"+
+			"| 日本語 | cjk       |\n"+
+			"| 한     | hangul    |\n"+
+			"| \x1b[32mgreen\x1b[0m  | colour    |\n"+
+			"| \x1b]8;;https://example.com\x1b\\link\x1b]8;;\x1b\\   | hyperlink |\n"+
+			"+--------+-----------+\n",
+		string(marshaledBytes))
+}
+
+func TestEastAsianWidth(t *testing.T) {
+	tablePrinter := tableprinter.New().WithBorders(true)
+	rows := []struct{ Ambiguous string }{{"±·"}, {"abcd"}}
+
+	// Ambiguous characters are narrow by default:
+	marshaledBytes, err := tablePrinter.WithEastAsianWidth(false).Marshal(rows)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"+-----------+\n"+
+			"| AMBIGUOUS |\n"+
+			"+-----------+\n"+
+			"| ±·        |\n"+
+			"| abcd      |\n"+
+			"+-----------+\n",
+		string(marshaledBytes))
+
+	// And wide on CJK terminals:
+	marshaledBytes, err = tablePrinter.WithEastAsianWidth(true).Marshal(rows)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"+-----------+\n"+
+			"| AMBIGUOUS |\n"+
+			"+-----------+\n"+
+			"| ±·      |\n"+
+			"| abcd      |\n"+
+			"+-----------+\n",
+		string(marshaledBytes))
+}
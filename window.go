@@ -0,0 +1,144 @@
+package tableprinter
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// PageIterator renders a value one page (of the configured page size) at a time:
+//
+//	pages := printer.WithPageSize(50).Paginate(value)
+//	for pages.Next() {
+//		fmt.Print(string(pages.Page()))
+//	}
+//	if err := pages.Err(); err != nil {
+//		...
+//	}
+type PageIterator struct {
+	err       error
+	offset    int
+	page      []byte
+	printer   *Printer
+	totalRows int
+	value     interface{}
+}
+
+// Paginate returns an iterator over the pages of a value (a page size of 0 makes one page of everything):
+func (p *Printer) Paginate(value interface{}) *PageIterator {
+	return &PageIterator{
+		offset:    p.offset,
+		printer:   p,
+		totalRows: -1,
+		value:     value,
+	}
+}
+
+// Next renders the next page (returning false once there are no more pages, or if there was an error):
+func (it *PageIterator) Next() bool {
+	if it.err != nil || (it.totalRows >= 0 && it.offset >= it.totalRows) {
+		return false
+	}
+
+	// Reflect just the rows on this page:
+	pagePrinter := it.printer.WithOffset(it.offset).WithLimit(it.printer.pageSize)
+	table, err := pagePrinter.ToTable(it.value)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	// Without a page size (or an offset) the table is not windowed, so every row is on this page:
+	if it.totalRows = table.totalRows; it.totalRows == 0 {
+		it.totalRows = table.NumRows()
+	}
+	if it.offset >= it.totalRows {
+		return false
+	}
+
+	// Render the page:
+	if it.page, it.err = pagePrinter.Render(table); it.err != nil {
+		return false
+	}
+
+	if it.printer.pageSize > 0 {
+		it.offset += it.printer.pageSize
+	} else {
+		it.offset = it.totalRows
+	}
+	return true
+}
+
+// Page returns the current page (as rendered by Next()):
+func (it *PageIterator) Page() []byte {
+	return it.page
+}
+
+// Err returns the error which stopped the iterator (if there was one):
+func (it *PageIterator) Err() error {
+	return it.err
+}
+
+// isWindowed determines whether the printer only shows some of the rows:
+func (p *Printer) isWindowed() bool {
+	return p.limit > 0 || p.offset > 0
+}
+
+// window returns the range of rows to show (out of a total number of rows):
+func (p *Printer) window(totalRows int) (int, int) {
+	start, end := p.offset, totalRows
+	if start < 0 {
+		start = 0
+	}
+	if start > totalRows {
+		start = totalRows
+	}
+	if p.limit > 0 && start+p.limit < end {
+		end = start + p.limit
+	}
+	return start, end
+}
+
+// windowTable reduces a table to the rows in the window (unless that has already been done):
+func (p *Printer) windowTable(table *Table) {
+	if table.totalRows > 0 || !p.isWindowed() {
+		return
+	}
+	start, end := p.window(len(table.rows))
+	table.firstRow, table.totalRows = start, len(table.rows)
+	table.rows = table.rows[start:end]
+}
+
+// makeWindowedTable turns a value into a table, only reflecting the slice elements which are in the window:
+func (p *Printer) makeWindowedTable(value interface{}) (*Table, error) {
+	reflectedValue := indirect(reflect.ValueOf(value))
+	if _, ok := value.(stringable); ok || !p.isWindowed() || reflectedValue.Kind() != reflect.Slice {
+		return p.makeTable(value)
+	}
+
+	start, end := p.window(reflectedValue.Len())
+	table, err := p.tableFromSliceRange(reflectedValue, start, end)
+	if err != nil {
+		return nil, err
+	}
+	table.firstRow, table.totalRows = start, reflectedValue.Len()
+
+	return table, nil
+}
+
+// windowNotice describes which rows of a windowed table are being shown (eg "showing 1–50 of 12,304 rows"):
+func (t *Table) windowNotice() string {
+	if t.totalRows == 0 || (t.firstRow == 0 && len(t.rows) == t.totalRows) {
+		return ""
+	}
+	return fmt.Sprintf("showing %s–%s of %s rows\n", formatCount(t.firstRow+1), formatCount(t.firstRow+len(t.rows)), formatCount(t.totalRows))
+}
+
+// formatCount renders a whole number with thousands separators:
+func formatCount(count int) string {
+	digits := strconv.Itoa(count)
+	for position := len(digits) - 3; position > 0; position -= 3 {
+		digits = digits[:position] + "," + digits[position:]
+	}
+	return digits
+}
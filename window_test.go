@@ -0,0 +1,106 @@
+package tableprinter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+// countedValue counts how many times it has been formatted:
+type countedValue struct {
+	count *int
+	name  string
+}
+
+func (v countedValue) String() string {
+	*v.count++
+	return v.name
+}
+
+type windowTestRow struct {
+	Name  countedValue
+	Index int
+}
+
+func windowTestRows(count int, formatCount *int) []windowTestRow {
+	var rows []windowTestRow
+	for i := 0; i < count; i++ {
+		rows = append(rows, windowTestRow{countedValue{formatCount, fmt.Sprintf("cruft-%d", i)}, i})
+	}
+	return rows
+}
+
+func TestLimitAndOffset(t *testing.T) {
+	var formatCount int
+	rows := windowTestRows(1234, &formatCount)
+	tablePrinter := tableprinter.New().WithLimit(2)
+
+	// Only the rows in the window are formatted:
+	marshaledBytes, err := tablePrinter.WithOffset(10).Marshal(rows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  INDEX |   NAME    \n+-------+----------+\n     10 | cruft-10  \n     11 | cruft-11  \nshowing 11–12 of 1,234 rows\n", string(marshaledBytes))
+	assert.Equal(t, 2, formatCount)
+
+	// Including when columns are selected:
+	formatCount = 0
+	marshaledBytes, err = tablePrinter.WithOffset(10).WithColumns("Name").WithIndexColumn(true).Marshal(rows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  INDEX |   NAME    \n+-------+----------+\n     10 | cruft-10  \n     11 | cruft-11  \nshowing 11–12 of 1,234 rows\n", string(marshaledBytes))
+	assert.Equal(t, 2, formatCount)
+
+	// The last window can be short:
+	marshaledBytes, err = tablePrinter.WithOffset(1233).Marshal(rows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  INDEX |    NAME     \n+-------+------------+\n   1233 | cruft-1233  \nshowing 1,234–1,234 of 1,234 rows\n", string(marshaledBytes))
+
+	// Windows which cover every row don't need a notice:
+	marshaledBytes, err = tablePrinter.WithLimit(5).Marshal(rows[:2])
+	assert.NoError(t, err)
+	assert.Equal(t, "  INDEX |  NAME    \n+-------+---------+\n      0 | cruft-0  \n      1 | cruft-1  \n", string(marshaledBytes))
+
+	// Hand-built tables are windowed when they are rendered:
+	table, err := tableprinter.NewTable("Name")
+	assert.NoError(t, err)
+	for _, name := range []string{"cruft-1", "cruft-2", "cruft-3"} {
+		assert.NoError(t, table.AddRow(name))
+	}
+	renderedBytes, err := tablePrinter.WithOffset(1).Render(table)
+	assert.NoError(t, err)
+	assert.Equal(t, "   NAME    \n+---------+\n  cruft-2  \n  cruft-3  \nshowing 2–3 of 3 rows\n", string(renderedBytes))
+
+	// Offsets past the end leave nothing to render:
+	_, err = tablePrinter.WithOffset(2000).Marshal(rows)
+	assert.Equal(t, tableprinter.ErrNoData, err)
+
+	// Negative offsets start at the beginning:
+	marshaledBytes, err = tablePrinter.WithOffset(-1).Marshal(rows)
+	assert.NoError(t, err)
+	assert.Equal(t, "  INDEX |  NAME    \n+-------+---------+\n      0 | cruft-0  \n      1 | cruft-1  \nshowing 1–2 of 1,234 rows\n", string(marshaledBytes))
+}
+
+func TestPaginate(t *testing.T) {
+	var formatCount int
+	rows := windowTestRows(5, &formatCount)
+
+	// Pages are rendered one at a time:
+	var pages []string
+	pageIterator := tableprinter.New().WithPageSize(2).Paginate(rows)
+	for pageIterator.Next() {
+		pages = append(pages, string(pageIterator.Page()))
+	}
+	assert.NoError(t, pageIterator.Err())
+	assert.Equal(t, []string{"  INDEX |  NAME    \n+-------+---------+\n      0 | cruft-0  \n      1 | cruft-1  \nshowing 1–2 of 5 rows\n", "  INDEX |  NAME    \n+-------+---------+\n      2 | cruft-2  \n      3 | cruft-3  \nshowing 3–4 of 5 rows\n", "  INDEX |  NAME    \n+-------+---------+\n      4 | cruft-4  \nshowing 5–5 of 5 rows\n"}, pages)
+
+	// Without a page size everything is on one page:
+	pageIterator = tableprinter.New().Paginate(rows[:2])
+	assert.True(t, pageIterator.Next())
+	assert.Equal(t, "  INDEX |  NAME    \n+-------+---------+\n      0 | cruft-0  \n      1 | cruft-1  \n", string(pageIterator.Page()))
+	assert.False(t, pageIterator.Next())
+
+	// Errors stop the iterator:
+	pageIterator = tableprinter.New().WithPath(".missing").Paginate(rows)
+	assert.False(t, pageIterator.Next())
+	assert.Error(t, pageIterator.Err())
+}
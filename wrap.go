@@ -0,0 +1,117 @@
+package tableprinter
+
+import (
+	"strings"
+)
+
+// wrapCells wraps the text of cells in columns with a MaxWidth (keeping their values). Lines which already fit are left
+// alone, so wrapping a table twice makes no difference:
+func (p *Printer) wrapCells(table *Table) *Table {
+	var wrappedTable = *table
+
+	wrappedTable.rows = nil
+	for _, row := range table.rows {
+		wrappedRow := make(tableRow)
+		for header, value := range row {
+			if columnConfig := p.columnConfig(header); columnConfig.MaxWidth > 0 {
+				value = value.withText(wrapText(value.text, columnConfig.MaxWidth, columnConfig.Wrap, p.eastAsianWidth))
+			}
+			wrappedRow.setField(header, value)
+		}
+		wrappedTable.addRow(wrappedRow)
+	}
+
+	return &wrappedTable
+}
+
+// hasWrappedColumns determines whether any columns have a MaxWidth:
+func (p *Printer) hasWrappedColumns() bool {
+	for _, columnConfig := range p.columnConfigs {
+		if columnConfig.MaxWidth > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// wrapText breaks each line of some text which is wider than a width onto more lines:
+func wrapText(text string, width int, wrap Wrap, eastAsianWidth bool) string {
+	var wrappedLines []string
+
+	for _, line := range strings.Split(text, "\n") {
+		switch {
+		case displayWidth(line, eastAsianWidth) <= width:
+			wrappedLines = append(wrappedLines, line)
+		case wrap == WrapHard:
+			wrappedLines = append(wrappedLines, breakText(line, width, eastAsianWidth)...)
+		default:
+			wrappedLines = append(wrappedLines, wrapWords(line, width, eastAsianWidth)...)
+		}
+	}
+
+	return strings.Join(wrappedLines, "\n")
+}
+
+// wrapWords fills lines with as many words as will fit (breaking up any words which are wider than the width):
+func wrapWords(text string, width int, eastAsianWidth bool) []string {
+	var lines []string
+	var line string
+
+	for _, word := range strings.Fields(text) {
+		switch {
+		case line == "":
+			line = word
+		case displayWidth(line+" "+word, eastAsianWidth) <= width:
+			line += " " + word
+			continue
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+
+		// Words which don't fit on a line of their own are broken up (the last part can be followed by more words):
+		if displayWidth(line, eastAsianWidth) > width {
+			brokenWord := breakText(line, width, eastAsianWidth)
+			lines = append(lines, brokenWord[:len(brokenWord)-1]...)
+			line = brokenWord[len(brokenWord)-1]
+		}
+	}
+
+	return append(lines, line)
+}
+
+// breakText breaks text into lines of a width (keeping colour codes, and never splitting a grapheme cluster):
+func breakText(text string, width int, eastAsianWidth bool) []string {
+	var lines []string
+	var line strings.Builder
+	var column int
+
+	for len(text) > 0 {
+
+		// Colour codes don't take up any space:
+		if location := ansiEscapeSequence.FindStringIndex(text); location != nil && location[0] == 0 {
+			line.WriteString(text[:location[1]])
+			text = text[location[1]:]
+			continue
+		}
+
+		// Take the text up to the next colour code:
+		plainText := text
+		if location := ansiEscapeSequence.FindStringIndex(text); location != nil {
+			plainText = text[:location[0]]
+		}
+		text = text[len(plainText):]
+
+		graphemes(plainText, eastAsianWidth, func(grapheme string, graphemeWidth int) {
+			if column > 0 && column+graphemeWidth > width {
+				lines = append(lines, line.String())
+				line.Reset()
+				column = 0
+			}
+			line.WriteString(grapheme)
+			column += graphemeWidth
+		})
+	}
+
+	return append(lines, line.String())
+}
@@ -0,0 +1,42 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+var wrapTestRows = []struct {
+	Name        string
+	Address     string
+	Description string
+}{
+	{"cruft", "1 Some Street\nSomewhere", "a long description of some cruft"},
+	{"crufty", "2 Another Street", "supercalifragilistic"},
+}
+
+func TestMultiLineCells(t *testing.T) {
+	marshaledBytes, err := tableprinter.New().WithBorders(true).WithSortedHeaders(false).Marshal(wrapTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "+--------+------------------+----------------------------------+\n|  NAME  |     ADDRESS      |           DESCRIPTION            |\n+--------+------------------+----------------------------------+\n| cruft  | 1 Some Street    | a long description of some cruft |\n|        | Somewhere        |                                  |\n| crufty | 2 Another Street | supercalifragilistic             |\n+--------+------------------+----------------------------------+\n", string(marshaledBytes))
+}
+
+func TestWrappedColumns(t *testing.T) {
+	tablePrinter := tableprinter.New().WithBorders(true).WithSortedHeaders(false)
+
+	// Word-wrapped (breaking up words which are too long):
+	marshaledBytes, err := tablePrinter.WithColumnConfig("Description", tableprinter.ColumnConfig{MaxWidth: 12}).Marshal(wrapTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "+--------+------------------+--------------+\n|  NAME  |     ADDRESS      | DESCRIPTION  |\n+--------+------------------+--------------+\n| cruft  | 1 Some Street    | a long       |\n|        | Somewhere        | description  |\n|        |                  | of some      |\n|        |                  | cruft        |\n| crufty | 2 Another Street | supercalifra |\n|        |                  | gilistic     |\n+--------+------------------+--------------+\n", string(marshaledBytes))
+
+	// Hard-wrapped:
+	marshaledBytes, err = tablePrinter.WithColumnConfig("Description", tableprinter.ColumnConfig{MaxWidth: 12, Wrap: tableprinter.WrapHard}).Marshal(wrapTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "+--------+------------------+--------------+\n|  NAME  |     ADDRESS      | DESCRIPTION  |\n+--------+------------------+--------------+\n| cruft  | 1 Some Street    | a long descr |\n|        | Somewhere        | iption of so |\n|        |                  | me cruft     |\n| crufty | 2 Another Street | supercalifra |\n|        |                  | gilistic     |\n+--------+------------------+--------------+\n", string(marshaledBytes))
+
+	// Wide characters are never split:
+	marshaledBytes, err = tablePrinter.WithColumnConfig("value", tableprinter.ColumnConfig{MaxWidth: 5, Wrap: tableprinter.WrapHard}).Marshal("日本語のテキスト")
+	assert.NoError(t, err)
+	assert.Equal(t, "+-------+\n| VALUE |\n+-------+\n| 日本  |\n| 語の  |\n| テキ  |\n| スト  |\n+-------+\n", string(marshaledBytes))
+}
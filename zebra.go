@@ -0,0 +1,55 @@
+package tableprinter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ZebraStyle configures how alternate rows are picked out (see WithZebra):
+type ZebraStyle struct {
+	Background     int // The 256-colour background of every other row when colour is enabled (eg 236, a dark grey)
+	BlankLineEvery int // When colour isn't enabled, how many rows to show between blank lines instead (eg 5)
+}
+
+// rowSpacing is what goes between rows (row separators, or the occasional blank line):
+type rowSpacing struct {
+	blankLineEvery int
+	rowSeparators  bool
+}
+
+// rowSpacing works out what goes between rows (blank lines are only needed when there is no colour to stripe rows with):
+func (p *Printer) rowSpacing() rowSpacing {
+	spacing := rowSpacing{rowSeparators: p.rowSeparators}
+	if !p.colour {
+		spacing.blankLineEvery = p.zebra.BlankLineEvery
+	}
+	return spacing
+}
+
+// linesAfter is the number of lines between a row and the next one (there are none after the last row):
+func (s rowSpacing) linesAfter(rowIndex, rows int) int {
+	switch {
+	case rowIndex >= rows-1:
+		return 0
+	case s.rowSeparators:
+		return 1
+	case s.blankLineEvery > 0 && (rowIndex+1)%s.blankLineEvery == 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// zebraBackground is the background colour of every other row (or 0 when rows aren't striped):
+func (p *Printer) zebraBackground() int {
+	if !p.colour {
+		return 0
+	}
+	return p.zebra.Background
+}
+
+// stripe gives a line a background colour (which is put back after any colour codes in the line reset it):
+func stripe(line string, background int) string {
+	backgroundColour := fmt.Sprintf(ansiBackground, background)
+	return backgroundColour + strings.Replace(line, ansiReset, ansiReset+backgroundColour, -1) + ansiReset
+}
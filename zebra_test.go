@@ -0,0 +1,47 @@
+package tableprinter_test
+
+import (
+	"testing"
+
+	"github.com/chrusty/go-tableprinter"
+	"github.com/stretchr/testify/assert"
+)
+
+var zebraTestRows = []struct {
+	Namespace string
+	Pod       string
+}{
+	{"default", "cruft-1"},
+	{"default", "cruft-2"},
+	{"kube-system", "cruft-3"},
+	{"kube-system", "cruft-4"},
+	{"monitoring", "cruft-5"},
+}
+
+func TestRowSeparators(t *testing.T) {
+	tablePrinter := tableprinter.New().WithBorders(true).WithRowSeparators(true)
+
+	// A line between each row:
+	marshaledBytes, err := tablePrinter.Marshal(zebraTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "+-------------+---------+\n|  NAMESPACE  |   POD   |\n+-------------+---------+\n| default     | cruft-1 |\n+-------------+---------+\n| default     | cruft-2 |\n+-------------+---------+\n| kube-system | cruft-3 |\n+-------------+---------+\n| kube-system | cruft-4 |\n+-------------+---------+\n| monitoring  | cruft-5 |\n+-------------+---------+\n", string(marshaledBytes))
+
+	// Left open across merged cells:
+	marshaledBytes, err = tablePrinter.WithMergedColumns("Namespace").Marshal(zebraTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "+-------------+---------+\n|  NAMESPACE  |   POD   |\n+-------------+---------+\n| default     | cruft-1 |\n|             +---------+\n|             | cruft-2 |\n+-------------+---------+\n| kube-system | cruft-3 |\n|             +---------+\n|             | cruft-4 |\n+-------------+---------+\n| monitoring  | cruft-5 |\n+-------------+---------+\n", string(marshaledBytes))
+}
+
+func TestZebra(t *testing.T) {
+	tablePrinter := tableprinter.New().WithBorders(true).WithZebra(tableprinter.ZebraStyle{Background: 236, BlankLineEvery: 2})
+
+	// Without colour there is a blank line every few rows:
+	marshaledBytes, err := tablePrinter.Marshal(zebraTestRows)
+	assert.NoError(t, err)
+	assert.Equal(t, "+-------------+---------+\n|  NAMESPACE  |   POD   |\n+-------------+---------+\n| default     | cruft-1 |\n| default     | cruft-2 |\n|             |         |\n| kube-system | cruft-3 |\n| kube-system | cruft-4 |\n|             |         |\n| monitoring  | cruft-5 |\n+-------------+---------+\n", string(marshaledBytes))
+
+	// With colour every other row is shaded:
+	marshaledBytes, err = tablePrinter.WithColour(true).Marshal(zebraTestRows[:3])
+	assert.NoError(t, err)
+	assert.Equal(t, "+-------------+---------+\n|  NAMESPACE  |   POD   |\n+-------------+---------+\n| default     | cruft-1 |\n\x1b[48;5;236m| default     | cruft-2 |\x1b[0m\n| kube-system | cruft-3 |\n+-------------+---------+\n", string(marshaledBytes))
+}